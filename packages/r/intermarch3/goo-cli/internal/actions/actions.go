@@ -0,0 +1,124 @@
+// Package actions holds the propose/dispute/vote business logic shared by
+// every front-end that drives oracle transactions: the `goo` CLI commands
+// and the Discord bot. Keeping it out of internal/commands lets discordbot
+// depend on it without importing cobra or creating an import cycle back
+// into internal/commands.
+package actions
+
+import (
+	"fmt"
+	"time"
+
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/metrics"
+	"goo-cli/internal/utils"
+	"goo-cli/internal/vault"
+)
+
+// ProposeValue submits a value proposal for requestID, querying and
+// sending the required bond. Shared by 'goo propose value' and the
+// Discord bot's /goo-propose.
+func ProposeValue(executor *gnokey.TxExecutor, requestID, value string) (bondUgnot int64, err error) {
+	bond, err := executor.QueryInt64("GetBond")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query bond amount: %w", err)
+	}
+
+	sendAmount := fmt.Sprintf("%dugnot", bond)
+	if err := executor.CallFunction("ProposeValue", []string{requestID, value}, sendAmount); err != nil {
+		return 0, err
+	}
+
+	return bond, nil
+}
+
+// DisputeCreate submits a dispute on requestID, querying and sending the
+// required bond. Shared by 'goo dispute create' and the Discord bot's
+// /goo-dispute.
+func DisputeCreate(executor *gnokey.TxExecutor, requestID string) (bondUgnot int64, err error) {
+	bond, err := executor.QueryInt64("GetBond")
+	if err != nil {
+		return 0, fmt.Errorf("failed to query bond amount: %w", err)
+	}
+
+	sendAmount := fmt.Sprintf("%dugnot", bond)
+	if err := executor.CallFunction("DisputeData", []string{requestID}, sendAmount); err != nil {
+		return 0, err
+	}
+	metrics.DisputesCreatedTotal.Inc()
+
+	return bond, nil
+}
+
+// VoteCommit hashes value+salt, submits the commit transaction, and
+// persists the vote in the encrypted vault for the later reveal. When salt
+// is empty, it's deterministically derived from the local recovery seed
+// (see vault.DeterministicSalt) instead of generated at random, so losing
+// the vault doesn't also mean losing the ability to reveal - only the
+// seed's mnemonic and passphrase are needed to reproduce it. mnemonic is
+// non-empty exactly once: the first time this machine ever derives a salt,
+// when a new seed had to be generated and must be shown to the user.
+// Shared by 'goo vote commit' and the Discord bot's /goo-vote-commit.
+func VoteCommit(executor *gnokey.TxExecutor, requestID, value, salt, passphrase string) (hash string, revealDeadline time.Time, mnemonic string, err error) {
+	result, err := executor.QueryFunction("GetDispute", []string{requestID})
+	if err != nil {
+		return "", time.Time{}, "", fmt.Errorf("failed to query dispute: %w", err)
+	}
+	dispute, err := gnoabi.DecodeDispute(result)
+	if err != nil {
+		return "", time.Time{}, "", fmt.Errorf("failed to decode dispute data: %w", err)
+	}
+
+	if salt == "" {
+		salt, hash, mnemonic, err = vault.DeterministicSalt(passphrase, executor.ChainID, executor.RealmPath, requestID, value)
+		if err != nil {
+			return "", time.Time{}, "", fmt.Errorf("failed to derive deterministic salt: %w", err)
+		}
+	} else {
+		hash = utils.GenerateVoteHash(value, salt)
+	}
+
+	if err := executor.CallFunction("VoteOnDispute", []string{requestID, hash}, ""); err != nil {
+		return "", time.Time{}, "", err
+	}
+
+	v, err := vault.Open(executor.ChainID, executor.KeyName)
+	if err != nil {
+		return "", time.Time{}, "", fmt.Errorf("failed to open vault: %w", err)
+	}
+	rec := vault.Record{
+		RequestID:      requestID,
+		Value:          value,
+		Salt:           salt,
+		Hash:           hash,
+		CommittedAt:    time.Now(),
+		RevealDeadline: dispute.RevealEndTime,
+	}
+	if err := v.Commit(rec, passphrase); err != nil {
+		return "", time.Time{}, "", fmt.Errorf("vote was committed on-chain but failed to save to vault: %w", err)
+	}
+
+	return hash, dispute.RevealEndTime, mnemonic, nil
+}
+
+// RevealVote loads requestID's vote from v and submits the reveal
+// transaction. Shared by 'goo vote reveal'/'goo vote watch' and the
+// Discord bot's /goo-vote-reveal.
+func RevealVote(executor *gnokey.TxExecutor, v *vault.Vault, requestID, passphrase string) error {
+	rec, err := v.Reveal(requestID, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to load vote from vault: %w", err)
+	}
+
+	funcArgs := []string{requestID, rec.Value, rec.Salt}
+	if err := executor.CallFunction("RevealVote", funcArgs, ""); err != nil {
+		return err
+	}
+
+	utils.PrintSuccess("Vote revealed successfully!")
+	utils.PrintInfo(fmt.Sprintf("Request ID: %s", requestID))
+	utils.PrintInfo(fmt.Sprintf("Value: %s", rec.Value))
+
+	return nil
+}
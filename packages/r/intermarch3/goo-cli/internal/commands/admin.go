@@ -7,7 +7,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"goo-cli/internal/config"
+	"goo-cli/internal/gnoabi"
 	"goo-cli/internal/gnokey"
+	"goo-cli/internal/metrics"
 	"goo-cli/internal/utils"
 )
 
@@ -23,17 +25,72 @@ func NewAdminCmd() *cobra.Command {
 	cmd.AddCommand(NewAdminSetRewardCmd())
 	cmd.AddCommand(NewAdminSetBondCmd())
 	cmd.AddCommand(NewAdminChangeAdminCmd())
+	cmd.AddCommand(NewAdminSignCmd())
+	cmd.AddCommand(NewAdminMultisignCmd())
 
 	return cmd
 }
 
+// requireSingleSigner refuses a direct, signed admin call whenever
+// cfg.MultisigKey is configured, whichever key would sign it: admin
+// operations against a multisig-governed contract go through
+// --generate-only, sign and multisign instead. --generate-only runs are let
+// through. The on-chain admin is queried only to name it in the error; it is
+// not compared against the multisig account's address.
+func requireSingleSigner(executor *gnokey.TxExecutor, cfg *config.Config, generateOnly bool) error {
+	if cfg.MultisigKey == "" || generateOnly {
+		return nil
+	}
+
+	result, err := executor.QueryFunction("GetAdmin", []string{})
+	if err != nil {
+		return fmt.Errorf("failed to verify admin account: %w", err)
+	}
+	onChainAdmin, err := gnoabi.DecodeString(result)
+	if err != nil {
+		return fmt.Errorf("failed to decode admin account: %w", err)
+	}
+
+	return fmt.Errorf("admin %s is a %d-of-n multisig account: use --generate-only, then 'goo admin sign' and 'goo admin multisign' instead of signing directly with %q", onChainAdmin, cfg.Threshold, executor.KeyName)
+}
+
+// runAdminTx either broadcasts funcArgs against funcName immediately, or, when
+// generateOnly is set, writes an unsigned transaction to outPath (or stdout)
+// for the offline sign -> multisign -> broadcast flow. op labels the
+// goo_admin_ops_total counter, incremented only once the operation actually
+// reaches the chain.
+func runAdminTx(executor *gnokey.TxExecutor, funcName, op string, funcArgs []string, generateOnly bool, outPath string) error {
+	if generateOnly {
+		unsignedTx, err := executor.GenerateUnsignedTx(funcName, funcArgs, "", outPath)
+		if err != nil {
+			return err
+		}
+		if outPath == "" {
+			fmt.Println(unsignedTx)
+		} else {
+			utils.PrintSuccess(fmt.Sprintf("Unsigned transaction written to %s", outPath))
+			utils.PrintInfo("Collect signatures with 'goo admin sign', then combine and broadcast with 'goo admin multisign'")
+		}
+		return nil
+	}
+
+	if err := executor.CallFunction(funcName, funcArgs, ""); err != nil {
+		return err
+	}
+	metrics.IncAdminOp(op)
+	return nil
+}
+
 // NewAdminSetResolutionDurationCmd sets resolution duration
 func NewAdminSetResolutionDurationCmd() *cobra.Command {
+	var generateOnly bool
+	var outPath string
+
 	cmd := &cobra.Command{
-		Use:   "set-resolution-duration <seconds>",
-		Short: "Set the resolution duration",
-		Long:  "Update the time window for resolving non-disputed proposals (admin only)",
-		Args:  cobra.ExactArgs(1),
+		Use:     "set-resolution-duration <seconds>",
+		Short:   "Set the resolution duration",
+		Long:    "Update the time window for resolving non-disputed proposals (admin only)",
+		Args:    cobra.ExactArgs(1),
 		Example: `  goo admin set-resolution-duration 120`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			duration, err := strconv.ParseInt(args[0], 10, 64)
@@ -43,16 +100,23 @@ func NewAdminSetResolutionDurationCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
+			if err := requireSingleSigner(executor, cfg, generateOnly); err != nil {
+				return err
+			}
+
 			utils.PrintWarning("This operation requires admin privileges!")
 
-			// Execute transaction
 			funcArgs := []string{fmt.Sprintf("%d", duration)}
-			if err := executor.CallFunction("SetResolutionDuration", funcArgs, ""); err != nil {
+			if err := runAdminTx(executor, "SetResolutionDuration", "set-resolution-duration", funcArgs, generateOnly, outPath); err != nil {
 				return err
 			}
+			if generateOnly {
+				return nil
+			}
 
 			utils.PrintSuccess("Resolution duration updated!")
 			utils.PrintInfo(fmt.Sprintf("New duration: %d seconds (%s)", duration, utils.FormatDuration(utils.DurationFromSeconds(duration))))
@@ -61,16 +125,22 @@ func NewAdminSetResolutionDurationCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&generateOnly, "generate-only", false, "Build the unsigned transaction without broadcasting (offline multisig flow)")
+	cmd.Flags().StringVarP(&outPath, "output", "o", "", "Write the unsigned transaction to this file instead of stdout (requires --generate-only)")
+
 	return cmd
 }
 
 // NewAdminSetRewardCmd sets requester reward
 func NewAdminSetRewardCmd() *cobra.Command {
+	var generateOnly bool
+	var outPath string
+
 	cmd := &cobra.Command{
-		Use:   "set-reward <amount>",
-		Short: "Set the requester reward amount",
-		Long:  "Update the default reward amount for requesters (admin only)",
-		Args:  cobra.ExactArgs(1),
+		Use:     "set-reward <amount>",
+		Short:   "Set the requester reward amount",
+		Long:    "Update the default reward amount for requesters (admin only)",
+		Args:    cobra.ExactArgs(1),
 		Example: `  goo admin set-reward 2000000`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			amount, err := strconv.ParseInt(args[0], 10, 64)
@@ -80,16 +150,23 @@ func NewAdminSetRewardCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
+			if err := requireSingleSigner(executor, cfg, generateOnly); err != nil {
+				return err
+			}
+
 			utils.PrintWarning("This operation requires admin privileges!")
 
-			// Execute transaction
 			funcArgs := []string{fmt.Sprintf("%d", amount)}
-			if err := executor.CallFunction("SetrequesterReward", funcArgs, ""); err != nil {
+			if err := runAdminTx(executor, "SetrequesterReward", "set-reward", funcArgs, generateOnly, outPath); err != nil {
 				return err
 			}
+			if generateOnly {
+				return nil
+			}
 
 			utils.PrintSuccess("Requester reward updated!")
 			utils.PrintInfo(fmt.Sprintf("New reward: %s", utils.FormatUgnot(amount)))
@@ -98,16 +175,22 @@ func NewAdminSetRewardCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&generateOnly, "generate-only", false, "Build the unsigned transaction without broadcasting (offline multisig flow)")
+	cmd.Flags().StringVarP(&outPath, "output", "o", "", "Write the unsigned transaction to this file instead of stdout (requires --generate-only)")
+
 	return cmd
 }
 
 // NewAdminSetBondCmd sets bond amount
 func NewAdminSetBondCmd() *cobra.Command {
+	var generateOnly bool
+	var outPath string
+
 	cmd := &cobra.Command{
-		Use:   "set-bond <amount>",
-		Short: "Set the bond amount",
-		Long:  "Update the bond amount required for proposals and disputes (admin only)",
-		Args:  cobra.ExactArgs(1),
+		Use:     "set-bond <amount>",
+		Short:   "Set the bond amount",
+		Long:    "Update the bond amount required for proposals and disputes (admin only)",
+		Args:    cobra.ExactArgs(1),
 		Example: `  goo admin set-bond 3000000`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			amount, err := strconv.ParseInt(args[0], 10, 64)
@@ -117,16 +200,23 @@ func NewAdminSetBondCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
+			if err := requireSingleSigner(executor, cfg, generateOnly); err != nil {
+				return err
+			}
+
 			utils.PrintWarning("This operation requires admin privileges!")
 
-			// Execute transaction
 			funcArgs := []string{fmt.Sprintf("%d", amount)}
-			if err := executor.CallFunction("SetBond", funcArgs, ""); err != nil {
+			if err := runAdminTx(executor, "SetBond", "set-bond", funcArgs, generateOnly, outPath); err != nil {
 				return err
 			}
+			if generateOnly {
+				return nil
+			}
 
 			utils.PrintSuccess("Bond amount updated!")
 			utils.PrintInfo(fmt.Sprintf("New bond: %s", utils.FormatUgnot(amount)))
@@ -135,33 +225,46 @@ func NewAdminSetBondCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&generateOnly, "generate-only", false, "Build the unsigned transaction without broadcasting (offline multisig flow)")
+	cmd.Flags().StringVarP(&outPath, "output", "o", "", "Write the unsigned transaction to this file instead of stdout (requires --generate-only)")
+
 	return cmd
 }
 
 // NewAdminChangeAdminCmd changes the admin address
 func NewAdminChangeAdminCmd() *cobra.Command {
+	var generateOnly bool
+	var outPath string
+
 	cmd := &cobra.Command{
-		Use:   "change-admin <address>",
-		Short: "Transfer admin privileges",
-		Long:  "Change the admin address to a new address (admin only)",
-		Args:  cobra.ExactArgs(1),
+		Use:     "change-admin <address>",
+		Short:   "Transfer admin privileges",
+		Long:    "Change the admin address to a new address (admin only)",
+		Args:    cobra.ExactArgs(1),
 		Example: `  goo admin change-admin g1abcdef...`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			newAdmin := args[0]
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
+			if err := requireSingleSigner(executor, cfg, generateOnly); err != nil {
+				return err
+			}
+
 			utils.PrintWarning("This operation requires admin privileges!")
 			utils.PrintWarning(fmt.Sprintf("You are transferring admin rights to: %s", newAdmin))
 
-			// Execute transaction
 			funcArgs := []string{newAdmin}
-			if err := executor.CallFunction("ChangeAdmin", funcArgs, ""); err != nil {
+			if err := runAdminTx(executor, "ChangeAdmin", "change-admin", funcArgs, generateOnly, outPath); err != nil {
 				return err
 			}
+			if generateOnly {
+				return nil
+			}
 
 			utils.PrintSuccess("Admin changed successfully!")
 			utils.PrintInfo(fmt.Sprintf("New admin: %s", newAdmin))
@@ -170,5 +273,102 @@ func NewAdminChangeAdminCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&generateOnly, "generate-only", false, "Build the unsigned transaction without broadcasting (offline multisig flow)")
+	cmd.Flags().StringVarP(&outPath, "output", "o", "", "Write the unsigned transaction to this file instead of stdout (requires --generate-only)")
+
+	return cmd
+}
+
+// NewAdminSignCmd produces one signer's partial signature over an unsigned
+// admin transaction, the second step of the offline multisig flow.
+func NewAdminSignCmd() *cobra.Command {
+	var outPath string
+
+	cmd := &cobra.Command{
+		Use:   "sign <unsigned-tx-file>",
+		Short: "Sign an unsigned multisig admin transaction",
+		Long:  "Produce this key's partial signature over a transaction generated with --generate-only, for later combination with 'goo admin multisign'",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo admin sign unsigned.json --key signer1 -o signer1.sig
+  goo admin sign unsigned.json --key signer2 -o signer2.sig`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unsignedTxPath := args[0]
+
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
+			if outPath == "" {
+				outPath = unsignedTxPath + "." + executor.KeyName + ".sig"
+			}
+
+			if err := executor.SignTx(unsignedTxPath, outPath); err != nil {
+				return err
+			}
+
+			utils.PrintSuccess(fmt.Sprintf("Signature written to %s", outPath))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&outPath, "output", "o", "", "Write the signature to this file (default: <unsigned-tx-file>.<key>.sig)")
+
+	return cmd
+}
+
+// NewAdminMultisignCmd combines partial signatures into a fully signed
+// transaction and broadcasts it, the final step of the offline multisig flow.
+func NewAdminMultisignCmd() *cobra.Command {
+	var broadcast bool
+	var outPath string
+
+	cmd := &cobra.Command{
+		Use:   "multisign <unsigned-tx-file> <signature-file>...",
+		Short: "Combine partial signatures and broadcast a multisig admin transaction",
+		Long:  "Combine signature files produced by 'goo admin sign' into a fully signed transaction, using --key as the multisig account, and broadcast it unless --broadcast=false is set",
+		Args:  cobra.MinimumNArgs(2),
+		Example: `  goo admin multisign unsigned.json signer1.sig signer2.sig --key oracle-multisig`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unsignedTxPath := args[0]
+			sigPaths := args[1:]
+
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
+			if cfg.Threshold > 0 && len(sigPaths) < cfg.Threshold {
+				return fmt.Errorf("only %d signature(s) provided, but threshold is %d", len(sigPaths), cfg.Threshold)
+			}
+
+			if outPath == "" {
+				outPath = unsignedTxPath + ".signed"
+			}
+
+			if err := executor.MultisignTx(unsignedTxPath, sigPaths, outPath); err != nil {
+				return err
+			}
+			utils.PrintSuccess(fmt.Sprintf("Signed transaction written to %s", outPath))
+
+			if !broadcast {
+				utils.PrintInfo(fmt.Sprintf("Run 'gnokey broadcast %s' (or re-run with --broadcast) to submit it", outPath))
+				return nil
+			}
+
+			if err := executor.BroadcastTx(outPath); err != nil {
+				return err
+			}
+			utils.PrintSuccess("Transaction broadcast successfully!")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&broadcast, "broadcast", true, "Broadcast the combined transaction after signing")
+	cmd.Flags().StringVarP(&outPath, "output", "o", "", "Write the signed transaction to this file (default: <unsigned-tx-file>.signed)")
+
 	return cmd
 }
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/discordbot"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+)
+
+// NewBotCmd creates the bot command
+func NewBotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bot",
+		Short: "Run chat bot front-ends for the oracle",
+		Long:  "Run a long-lived bot that notifies a chat platform of oracle state changes and lets linked users drive propose/dispute/vote transactions",
+	}
+
+	cmd.AddCommand(NewBotDiscordCmd())
+
+	return cmd
+}
+
+// NewBotDiscordCmd runs the Discord bot front-end
+func NewBotDiscordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discord",
+		Short: "Run the Discord bot",
+		Long:  "Connect to Discord, register the goo-propose/goo-dispute/goo-vote-commit/goo-vote-reveal slash commands, and post channel notifications as requests and disputes change state. Requires discord_bot_token and discord_user_keys in config.",
+		Example: `  goo bot discord`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
+			bot, err := discordbot.New(cfg, executor)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			utils.PrintInfo("Starting Discord bot (Ctrl+C to stop)...")
+			return bot.Run(ctx)
+		},
+	}
+
+	return cmd
+}
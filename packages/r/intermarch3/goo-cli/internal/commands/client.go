@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"goo-cli/internal/config"
+	"goo-cli/pkg/gooclient"
+)
+
+// clientContextFrom builds a gooclient.ClientContext from cfg, for
+// commands that talk to the oracle through pkg/gooclient instead of
+// internal/gnokey directly. gasFee overrides cfg.GasFee when non-empty,
+// which lets callers resolve a "--gas auto" flag (see resolveGasFee) before
+// the Client is constructed, since ClientContext.GasFee can't be changed
+// after the fact.
+func clientContextFrom(cfg *config.Config, gasFee string) gooclient.ClientContext {
+	if gasFee == "" {
+		gasFee = cfg.GasFee
+	}
+	return gooclient.ClientContext{
+		KeyName:      cfg.KeyName,
+		RealmPath:    cfg.RealmPath,
+		ChainID:      cfg.ChainID,
+		Remote:       cfg.Remote,
+		GasFee:       gasFee,
+		GasWanted:    cfg.GasWanted,
+		GoogleAPIKey: cfg.GoogleAPIKey,
+	}
+}
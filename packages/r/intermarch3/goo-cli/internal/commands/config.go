@@ -22,6 +22,9 @@ func NewConfigCmd() *cobra.Command {
 
 	cmd.AddCommand(NewConfigInitCmd())
 	cmd.AddCommand(NewConfigShowCmd())
+	cmd.AddCommand(NewConfigListCmd())
+	cmd.AddCommand(NewConfigUseCmd())
+	cmd.AddCommand(NewConfigAddCmd())
 
 	return cmd
 }
@@ -120,6 +123,7 @@ func NewConfigInitCmd() *cobra.Command {
 			}
 
 			// Save config
+			cfg.SyncActiveProfile()
 			if err := config.Save(cfg); err != nil {
 				return err
 			}
@@ -173,9 +177,15 @@ func NewConfigShowCmd() *cobra.Command {
 		Long:  "Display the current CLI configuration",
 		Example: `  goo config show`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg := config.Load()
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides("", profileOverride)
+			activeProfile := cfg.CurrentProfile
+			if profileOverride != "" {
+				activeProfile = profileOverride
+			}
 
 			utils.PrintSection("Current Configuration")
+			utils.PrintKeyValue("Profile", activeProfile)
 			utils.PrintKeyValue("Key Name", cfg.KeyName)
 			utils.PrintKeyValue("Realm Path", cfg.RealmPath)
 			utils.PrintKeyValue("Chain ID", cfg.ChainID)
@@ -205,3 +215,147 @@ func NewConfigShowCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewConfigListCmd lists the configured profiles
+func NewConfigListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List configured profiles",
+		Long:    "List every named profile in config.yaml, marking the current one",
+		Example: `  goo config list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := config.Load()
+
+			utils.PrintSection("Profiles")
+			for name, p := range cfg.Profiles {
+				marker := "  "
+				if name == cfg.CurrentProfile {
+					marker = "* "
+				}
+				fmt.Printf("%s%s\n", marker, name)
+				utils.PrintKeyValue("    Chain ID", p.ChainID)
+				utils.PrintKeyValue("    Remote", p.Remote)
+				utils.PrintKeyValue("    Realm Path", p.RealmPath)
+				utils.PrintKeyValue("    Key Name", p.KeyName)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewConfigUseCmd switches the current profile
+func NewConfigUseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "use <profile>",
+		Short:   "Switch the current profile",
+		Long:    "Set current_profile in config.yaml so commands target that profile by default, without needing --profile",
+		Args:    cobra.ExactArgs(1),
+		Example: `  goo config use mainnet`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			cfg := config.Load()
+
+			if _, ok := cfg.Profiles[name]; !ok {
+				return fmt.Errorf("profile %q not found; see 'goo config list' or create it with 'goo config add'", name)
+			}
+
+			cfg.CurrentProfile = name
+			if err := config.Save(cfg); err != nil {
+				return err
+			}
+
+			utils.PrintSuccess(fmt.Sprintf("Switched to profile %q", name))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewConfigAddCmd adds a new named profile
+func NewConfigAddCmd() *cobra.Command {
+	var (
+		chainID   string
+		remote    string
+		realmPath string
+		keyName   string
+		gasFee    string
+		gasWanted int64
+		use       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add <profile>",
+		Short: "Add a new named profile",
+		Long:  "Add a profile to config.yaml for targeting another gno.land realm deployment of the oracle",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo config add mainnet --chain-id mainnet --remote https://rpc.gno.land:443 --realm-path gno.land/r/intermarch3/goo --use`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			cfg := config.Load()
+
+			if _, exists := cfg.Profiles[name]; exists {
+				return fmt.Errorf("profile %q already exists; edit ~/.goo/config.yaml directly to change it", name)
+			}
+
+			base := cfg.Profiles[cfg.CurrentProfile]
+			profile := config.Profile{
+				KeyName:   base.KeyName,
+				RealmPath: base.RealmPath,
+				ChainID:   base.ChainID,
+				Remote:    base.Remote,
+				GasFee:    base.GasFee,
+				GasWanted: base.GasWanted,
+			}
+			if keyName != "" {
+				profile.KeyName = keyName
+			}
+			if realmPath != "" {
+				profile.RealmPath = realmPath
+			}
+			if chainID != "" {
+				profile.ChainID = chainID
+			}
+			if remote != "" {
+				profile.Remote = remote
+			}
+			if gasFee != "" {
+				profile.GasFee = gasFee
+			}
+			if gasWanted != 0 {
+				profile.GasWanted = gasWanted
+			}
+
+			if cfg.Profiles == nil {
+				cfg.Profiles = map[string]config.Profile{}
+			}
+			cfg.Profiles[name] = profile
+			if use {
+				cfg.CurrentProfile = name
+			}
+
+			if err := config.Save(cfg); err != nil {
+				return err
+			}
+
+			utils.PrintSuccess(fmt.Sprintf("Profile %q added", name))
+			if use {
+				utils.PrintInfo(fmt.Sprintf("Switched to profile %q", name))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&chainID, "chain-id", "", "Chain ID for this profile (default: copy from current profile)")
+	cmd.Flags().StringVar(&remote, "remote", "", "RPC remote for this profile (default: copy from current profile)")
+	cmd.Flags().StringVar(&realmPath, "realm-path", "", "Realm path for this profile (default: copy from current profile)")
+	cmd.Flags().StringVar(&keyName, "key-name", "", "gnokey keyname for this profile (default: copy from current profile)")
+	cmd.Flags().StringVar(&gasFee, "gas-fee", "", "Gas fee for this profile (default: copy from current profile)")
+	cmd.Flags().Int64Var(&gasWanted, "gas-wanted", 0, "Gas wanted for this profile (default: copy from current profile)")
+	cmd.Flags().BoolVar(&use, "use", false, "Switch to this profile immediately after adding it")
+
+	return cmd
+}
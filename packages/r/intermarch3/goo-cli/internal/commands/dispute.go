@@ -8,6 +8,7 @@ import (
 	"goo-cli/internal/config"
 	"goo-cli/internal/gnokey"
 	"goo-cli/internal/utils"
+	"goo-cli/pkg/gooclient"
 )
 
 // NewDisputeCmd creates the dispute command
@@ -27,44 +28,72 @@ func NewDisputeCmd() *cobra.Command {
 
 // NewDisputeCreateCmd creates a new dispute
 func NewDisputeCreateCmd() *cobra.Command {
+	var evidenceFile string
+
 	cmd := &cobra.Command{
 		Use:   "create <request-id>",
 		Short: "Create a dispute on a proposed value",
 		Long:  "Challenge a proposed value by creating a dispute. Requires bond to be sent with the transaction.",
 		Args:  cobra.ExactArgs(1),
-		Example: `  goo dispute create 0000001`,
+		Example: `  goo dispute create 0000001
+  goo dispute create 0000001 --evidence-file counter-evidence.json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			requestID := args[0]
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
-			executor := gnokey.NewExecutor(cfg, verbose)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			// DisputeData takes no ancillary text field, so evidence can't be
+			// anchored directly in the transaction; sign and print the
+			// binding for out-of-band reference (e.g. shared with voters).
+			if evidenceFile != "" {
+				executor := gnokey.NewExecutor(cfg, verbose)
+				if _, err := buildEvidenceSuffix(executor, evidenceFile); err != nil {
+					return err
+				}
+			}
 
-			// Query the required bond amount from contract
-			utils.PrintInfo("Querying required bond amount from contract...")
-			bond, err := executor.QueryInt64("GetBond")
+			gasFlag, _ := cmd.Flags().GetString("gas")
+			gasFee, err := resolveGasFee(gasFlag, cfg)
 			if err != nil {
-				return fmt.Errorf("failed to query bond amount: %w", err)
+				return err
 			}
+			client := gooclient.New(clientContextFrom(cfg, gasFee))
+			client.Verbose(verbose)
 
-			utils.PrintInfo(fmt.Sprintf("Bond required: %d ugnot", bond))
+			waitTarget, waitTimeout, waitOK, err := parseWaitFlags(cmd)
+			if err != nil {
+				return err
+			}
 
-			// Execute transaction with bond
-			sendAmount := fmt.Sprintf("%dugnot", bond)
-			if err := executor.CallFunction("DisputeData", []string{requestID}, sendAmount); err != nil {
+			utils.PrintInfo("Querying required bond amount from contract...")
+			bond, err := client.Dispute(requestID)
+			if err != nil {
 				return err
 			}
 
+			utils.PrintInfo(fmt.Sprintf("Bond required: %d ugnot", bond))
+
 			utils.PrintSuccess("Dispute created successfully!")
 			utils.PrintInfo(fmt.Sprintf("Request ID: %s", requestID))
 			utils.PrintInfo("Voting period has started")
 			utils.PrintInfo(fmt.Sprintf("Bond sent: %d ugnot", bond))
 
+			if waitOK {
+				executor := gnokey.NewExecutor(cfg, verbose)
+				return WaitForState(cmd.Context(), executor, requestID, waitTarget, waitTimeout)
+			}
+
 			return nil
 		},
 	}
 
+	cmd.Flags().StringVar(&evidenceFile, "evidence-file", "", "Sign and hash this file as counter-evidence for the dispute (printed for off-chain reference; the contract has no ancillary-text field to anchor it in)")
+	addGasFlag(cmd)
+	addWaitFlags(cmd)
+
 	return cmd
 }
 
@@ -81,26 +110,16 @@ func NewDisputeGetCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
-			executor := gnokey.NewExecutor(cfg, verbose)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			client := gooclient.New(clientContextFrom(cfg, ""))
+			client.Verbose(verbose)
 
-			// Query the dispute
-			result, err := executor.QueryFunction("GetDispute", []string{requestID})
+			dispute, err := client.QueryDispute(requestID)
 			if err != nil {
 				return err
 			}
 
-			// Parse the dispute data
-			dispute, err := utils.ParseDisputeFromQuery(result)
-			if err != nil {
-				// If parsing fails, show raw output in verbose mode
-				if verbose {
-					utils.PrintError(fmt.Sprintf("Failed to parse dispute: %v", err))
-					fmt.Println(result)
-				}
-				return fmt.Errorf("failed to parse dispute data: %w", err)
-			}
-
 			// Display dispute information in a clean format
 			utils.PrintSection(fmt.Sprintf("Dispute for Request %s", dispute.RequestID))
 			fmt.Println()
@@ -108,20 +127,27 @@ func NewDisputeGetCmd() *cobra.Command {
 			// Status Information
 			fmt.Println("Status:")
 			utils.PrintKeyValue("  Request ID", dispute.RequestID)
-			if dispute.IsResolved {
+			if dispute.Resolved {
 				utils.PrintKeyValue("  Status", "Resolved")
 				utils.PrintKeyValue("  Winning Value", dispute.WinningValue)
 			} else {
 				utils.PrintKeyValue("  Status", "Active")
 			}
+			utils.PrintKeyValue("  Vote End", utils.FormatTimeRFC3339(dispute.VoteEndTime))
+			utils.PrintKeyValue("  Reveal End", utils.FormatTimeRFC3339(dispute.RevealEndTime))
 
 			// Voting Information
+			revealed := int64(0)
+			for _, v := range dispute.Votes {
+				if v.Revealed {
+					revealed++
+				}
+			}
 			fmt.Println()
 			fmt.Println("Voting:")
-			utils.PrintKeyValue("  Total Votes", dispute.Votes)
-			utils.PrintKeyValue("  Revealed Votes", dispute.NbResolvedVotes)
-			unrevealed := int64(dispute.Votes) - dispute.NbResolvedVotes
-			utils.PrintKeyValue("  Unrevealed Votes", unrevealed)
+			utils.PrintKeyValue("  Total Votes", dispute.TotalVotes)
+			utils.PrintKeyValue("  Revealed Votes", revealed)
+			utils.PrintKeyValue("  Unrevealed Votes", dispute.TotalVotes-revealed)
 			fmt.Println()
 
 			return nil
@@ -144,7 +170,8 @@ func NewDisputeResolveCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
 			// Execute transaction
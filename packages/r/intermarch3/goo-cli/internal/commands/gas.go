@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+)
+
+// addGasFlag registers the shared --gas flag on a tx-issuing command.
+func addGasFlag(cmd *cobra.Command) {
+	cmd.Flags().String("gas", "", `Gas fee: "auto" to estimate from recent block utilization, or an explicit amount like "2000000ugnot" (default: profile's configured gas_fee)`)
+}
+
+// applyGasFlag reads this command's --gas flag and applies it to executor.
+// An unset flag leaves the profile's configured gas_fee untouched.
+func applyGasFlag(cmd *cobra.Command, executor *gnokey.TxExecutor, cfg *config.Config) error {
+	gasFlag, _ := cmd.Flags().GetString("gas")
+	resolved, err := resolveGasFee(gasFlag, cfg)
+	if err != nil {
+		return err
+	}
+	if resolved != "" {
+		executor.GasFee = resolved
+	}
+	return nil
+}
+
+// resolveGasFee turns this command's --gas flag value into the gas fee
+// string that should actually be sent: an unset flag resolves to "" (leave
+// the profile's configured gas_fee untouched), "auto" samples recent block
+// gas utilization via gnokey.EstimateGas and scales cfg.GasFee accordingly
+// (warning if the result exceeds cfg.GasCeilingUgnot), and anything else is
+// returned verbatim as the gas fee.
+func resolveGasFee(gasFlag string, cfg *config.Config) (string, error) {
+	if gasFlag == "" {
+		return "", nil
+	}
+
+	if gasFlag != "auto" {
+		return gasFlag, nil
+	}
+
+	baseFee, err := utils.ParseUgnotAmount(cfg.GasFee)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base gas_fee %q: %w", cfg.GasFee, err)
+	}
+
+	estimate, err := gnokey.EstimateGas(cfg.Remote, int(cfg.GasSampleBlocks), baseFee)
+	if err != nil {
+		return "", fmt.Errorf("gas estimation failed: %w", err)
+	}
+
+	resolved := fmt.Sprintf("%dugnot", estimate.EstimatedUgnot)
+	utils.PrintInfo(fmt.Sprintf("Adaptive gas: %.0f%% block utilization over last %d blocks -> %.2fx base fee -> %dugnot", estimate.Utilization*100, estimate.SampledBlocks, estimate.Multiplier, estimate.EstimatedUgnot))
+
+	if cfg.GasCeilingUgnot > 0 && estimate.EstimatedUgnot > cfg.GasCeilingUgnot {
+		utils.PrintWarning(fmt.Sprintf("Estimated gas fee %dugnot exceeds configured ceiling of %dugnot", estimate.EstimatedUgnot, cfg.GasCeilingUgnot))
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/index"
+	"goo-cli/internal/utils"
+)
+
+// NewIndexCmd creates the index command
+func NewIndexCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index",
+		Short: "Manage the local offline request/dispute cache",
+		Long:  "Pull and inspect a local cache of requests and disputes, so 'query list --local' and 'query dispute --local' can answer without RPC",
+	}
+
+	cmd.AddCommand(NewIndexSyncCmd())
+	cmd.AddCommand(NewIndexStatusCmd())
+
+	return cmd
+}
+
+// openLocalIndex opens the on-disk cache at its default location.
+func openLocalIndex() (*index.Index, error) {
+	dir, err := index.DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	return index.Open(dir)
+}
+
+// NewIndexSyncCmd pulls requests and disputes into the local cache
+func NewIndexSyncCmd() *cobra.Command {
+	var full bool
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Pull requests and disputes into the local cache",
+		Long:  "Fetch requests (and their disputes, once disputed) from the chain into ~/.goo/index. Non-terminal requests are always re-fetched; Resolved requests are treated as immutable and skipped unless --full is given.",
+		Example: `  goo index sync
+  goo index sync --full`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
+			idx, err := openLocalIndex()
+			if err != nil {
+				return err
+			}
+
+			utils.PrintInfo("Syncing local index...")
+			summary, err := idx.Sync(executor, full)
+			if err != nil {
+				return err
+			}
+
+			utils.PrintSuccess("Index sync complete")
+			utils.PrintKeyValue("  Fetched", summary.Fetched)
+			utils.PrintKeyValue("  Skipped (cached)", summary.Skipped)
+			utils.PrintKeyValue("  Disputes Cached", summary.Disputed)
+			utils.PrintKeyValue("  Total Cached", summary.Total)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&full, "full", false, "Re-fetch every request, including cached ones, instead of only new or non-terminal ones")
+
+	return cmd
+}
+
+// NewIndexStatusCmd reports the local cache's state
+func NewIndexStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the local cache's size and freshness",
+		Example: `  goo index status`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			idx, err := openLocalIndex()
+			if err != nil {
+				return err
+			}
+
+			utils.PrintSection("Local Index Status")
+			fmt.Println()
+			utils.PrintKeyValue("  Directory", idx.Dir)
+			utils.PrintKeyValue("  Requests Cached", idx.Len())
+			if high := idx.HighWaterID(); high != "" {
+				utils.PrintKeyValue("  High Water ID", high)
+			} else {
+				utils.PrintKeyValue("  High Water ID", "(never synced)")
+			}
+			fmt.Println()
+
+			upcoming := idx.UpcomingDeadlines(5)
+			if len(upcoming) > 0 {
+				utils.PrintSection("Upcoming Deadlines")
+				fmt.Println()
+				for _, req := range upcoming {
+					utils.PrintKeyValue("  "+req.ID, fmt.Sprintf("%s (%s, %s)", utils.FormatTimeRFC3339(req.Deadline), timeUntilLabel(req.Deadline), req.State))
+				}
+				fmt.Println()
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -2,16 +2,20 @@ package commands
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"goo-cli/internal/config"
 	"goo-cli/internal/gnokey"
+	"goo-cli/internal/research"
 	"goo-cli/internal/search_agent"
 	"goo-cli/internal/utils"
+	"goo-cli/pkg/gooclient"
 )
 
 // NewProposeCmd creates the propose command
@@ -28,9 +32,24 @@ func NewProposeCmd() *cobra.Command {
 	return cmd
 }
 
+// providerConfigFrom builds a search_agent.ProviderConfig from the CLI config.
+func providerConfigFrom(cfg *config.Config) search_agent.ProviderConfig {
+	return search_agent.ProviderConfig{
+		GoogleAPIKey:    cfg.GoogleAPIKey,
+		OpenAIAPIKey:    cfg.OpenAIAPIKey,
+		AnthropicAPIKey: cfg.AnthropicAPIKey,
+		OllamaEndpoint:  cfg.OllamaEndpoint,
+		OllamaModel:     cfg.OllamaModel,
+	}
+}
+
 // NewProposeValueCmd proposes a value for a request
 func NewProposeValueCmd() *cobra.Command {
-	var searchFlag bool
+	var (
+		searchFlag bool
+		provider   string
+		consensusN int
+	)
 
 	cmd := &cobra.Command{
 		Use:   "value <request-id> [value]",
@@ -39,10 +58,16 @@ func NewProposeValueCmd() *cobra.Command {
 		Args:  cobra.RangeArgs(1, 2),
 		Example: `  # Manual proposal
   goo propose value 0000001 3500
-  
+
   # AI-powered proposal with web search
   goo propose value 0000001 --search
-  
+
+  # AI-powered proposal using a specific provider
+  goo propose value 0000001 --search --provider openai
+
+  # Require agreement across 3 providers before proposing
+  goo propose value 0000001 --search --consensus 3
+
   # With custom key
   goo propose value 0000001 --search --key mykey`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -51,54 +76,61 @@ func NewProposeValueCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
-			executor := gnokey.NewExecutor(cfg, verbose)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			gasFlag, _ := cmd.Flags().GetString("gas")
+			gasFee, err := resolveGasFee(gasFlag, cfg)
+			if err != nil {
+				return err
+			}
+			client := gooclient.New(clientContextFrom(cfg, gasFee))
+			client.Verbose(verbose)
+
+			if provider == "" {
+				provider = cfg.Provider
+			}
+			if provider == "" {
+				provider = "gemini"
+			}
 
 			// If --search flag is used, query AI for the value
 			if searchFlag {
-				// Check if API key is configured
-				if cfg.GoogleAPIKey == "" {
-					return fmt.Errorf("❌ Google API key not configured. Run 'goo config init' or set it manually in ~/.goo/config.yaml")
-				}
+				ctx := cmd.Context()
 
 				// Query request details from smart contract
 				utils.PrintInfo(fmt.Sprintf("Fetching request details for ID: %s", requestID))
-				requestResult, err := executor.QueryFunction("GetRequest", []string{requestID})
+				req, err := client.QueryRequest(requestID)
 				if err != nil {
 					return fmt.Errorf("failed to fetch request details: %w", err)
 				}
 
-				// Parse the request to get the question
-				req, err := utils.ParseDataRequestFromQuery(requestResult)
-				if err != nil {
-					return fmt.Errorf("failed to parse request: %w", err)
-				}
-
 				question := req.AncillaryData
 				isYesNo := req.YesNoQuestion
-				
+				kind := search_agent.KindNumeric
+				if isYesNo {
+					kind = search_agent.KindYesNo
+				}
+
 				fmt.Println()
 				fmt.Printf("Question: %s\n", question)
 				fmt.Println()
 
-				// Initialize Gemini client
-				geminiClient, err := search_agent.NewGeminiClient(cfg.GoogleAPIKey, verbose)
-				if err != nil {
-					fmt.Println()
-					utils.PrintError(fmt.Sprintf("Failed to initialize AI client: %v", err))
-					fmt.Println()
-					return nil // Exit gracefully, error already displayed
+				var response *search_agent.OracleResponse
+				if consensusN > 1 {
+					response, err = queryConsensus(ctx, cfg, requestID, question, kind, consensusN, verbose)
+				} else {
+					response, err = querySingleProvider(ctx, cfg, requestID, provider, question, kind, verbose)
 				}
-				defer geminiClient.Close()
-
-				// Query Gemini for the answer
-				response, err := geminiClient.QueryQuestion(question)
 				if err != nil {
 					fmt.Println()
-					utils.PrintError(fmt.Sprintf("AI research failed: %v", err))
+					utils.PrintError(err.Error())
 					fmt.Println()
 					return nil // Exit gracefully, error already displayed
 				}
+				if response == nil {
+					return nil // A helper already printed why it's bailing out.
+				}
 
 				// Check for special error cases
 				if response.Value == "FUTURE_QUESTION_ERROR" {
@@ -209,38 +241,161 @@ func NewProposeValueCmd() *cobra.Command {
 				value = args[1]
 			}
 
-			// Query the required bond amount from contract
+			waitTarget, waitTimeout, waitOK, err := parseWaitFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			utils.PrintInfo("Querying required bond amount from contract...")
-			bond, err := executor.QueryInt64("GetBond")
+			bond, err := client.Propose(requestID, value)
 			if err != nil {
-				return fmt.Errorf("failed to query bond amount: %w", err)
+				return err
 			}
 
 			utils.PrintInfo(fmt.Sprintf("Bond required: %d ugnot", bond))
 			fmt.Println()
 
-			// Execute transaction with bond
-			funcArgs := []string{requestID, value}
-			sendAmount := fmt.Sprintf("%dugnot", bond)
-
-			if err := executor.CallFunction("ProposeValue", funcArgs, sendAmount); err != nil {
-				return err
-			}
-
 			utils.PrintSuccess("Value proposed successfully!")
 			utils.PrintInfo(fmt.Sprintf("Request ID: %s", requestID))
 			utils.PrintInfo(fmt.Sprintf("Proposed Value: %s", value))
 			utils.PrintInfo(fmt.Sprintf("Bond sent: %d ugnot", bond))
 
+			if waitOK {
+				executor := gnokey.NewExecutor(cfg, verbose)
+				return WaitForState(cmd.Context(), executor, requestID, waitTarget, waitTimeout)
+			}
+
 			return nil
 		},
 	}
 
 	cmd.Flags().BoolVar(&searchFlag, "search", false, "Use AI-powered search to propose a value automatically")
+	cmd.Flags().StringVar(&provider, "provider", "", "AI provider to use: gemini, openai, anthropic, or ollama (default: config provider)")
+	cmd.Flags().IntVar(&consensusN, "consensus", 0, "Fan out to N configured providers and require agreement before proposing")
+	addGasFlag(cmd)
+	addWaitFlags(cmd)
 
 	return cmd
 }
 
+// querySingleProvider researches question using a single named AI provider,
+// caching the run for later audit via cacheResearch.
+func querySingleProvider(ctx context.Context, cfg *config.Config, requestID, provider, question string, kind search_agent.QuestionKind, verbose bool) (*search_agent.OracleResponse, error) {
+	client, err := search_agent.NewProvider(provider, providerConfigFrom(cfg), verbose)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize %s client: %w", provider, err)
+	}
+	defer client.Close()
+
+	// Gemini streams its answer incrementally; in verbose mode, print each
+	// chunk as it arrives instead of waiting for the full response.
+	if verbose {
+		if gc, ok := client.(*search_agent.GeminiClient); ok {
+			gc.OnProgress(func(chunk string) {
+				fmt.Fprint(os.Stderr, chunk)
+			})
+		}
+	}
+
+	response, err := client.QueryQuestion(ctx, question, kind)
+	if err != nil {
+		return nil, fmt.Errorf("AI research failed (%s): %w", provider, err)
+	}
+
+	cacheResearch(requestID, question, client.Name(), client.Model(), response)
+
+	return response, nil
+}
+
+// cacheResearch saves response as an attested research.Record so the
+// research used for a proposal can be reviewed and reproduced later.
+func cacheResearch(requestID, question, provider, model string, response *search_agent.OracleResponse) {
+	rec := research.Record{
+		RequestID:       requestID,
+		Timestamp:       time.Now(),
+		Question:        question,
+		Provider:        provider,
+		Model:           model,
+		RawResponse:     response.RawResponse,
+		NormalizedValue: strings.TrimSpace(response.Value),
+		Sources:         response.Sources,
+	}
+	rec.AttestationHash = research.Attest(rec.Question, rec.Provider, rec.Model, rec.RawResponse)
+
+	path, err := research.Save(rec)
+	if err != nil {
+		utils.PrintWarning(fmt.Sprintf("Failed to cache research: %v", err))
+		return
+	}
+	utils.PrintInfo(fmt.Sprintf("Research cached: %s (attestation %s)", path, rec.AttestationHash))
+}
+
+// queryConsensus fans the question out to the first n configured providers
+// and refuses to return a value when they disagree beyond cfg.ConsensusThreshold.
+// Each provider's run is cached independently via cacheResearch so a
+// disputer can audit the exact evidence behind every vote, not just the
+// synthesized consensus value.
+func queryConsensus(ctx context.Context, cfg *config.Config, requestID, question string, kind search_agent.QuestionKind, n int, verbose bool) (*search_agent.OracleResponse, error) {
+	names := cfg.ConsensusProviders
+	if len(names) == 0 {
+		names = []string{"gemini", "openai", "anthropic"}
+	}
+	providerConfig := providerConfigFrom(cfg)
+	var providers []search_agent.Oracle
+	for _, name := range names {
+		if len(providers) == n {
+			break
+		}
+		client, err := search_agent.NewProvider(name, providerConfig, verbose)
+		if err != nil {
+			utils.PrintWarning(fmt.Sprintf("Skipping provider %s: %v", name, err))
+			continue
+		}
+		defer client.Close()
+		providers = append(providers, client)
+	}
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no consensus providers could be initialized (check API keys in ~/.goo/config.yaml)")
+	}
+
+	modelByProvider := make(map[string]string, len(providers))
+	for _, p := range providers {
+		modelByProvider[p.Name()] = p.Model()
+	}
+
+	ensemble := search_agent.NewEnsemble(providers, cfg.ConsensusThreshold, verbose)
+	result, err := ensemble.Query(ctx, question, kind)
+	if err != nil {
+		return nil, fmt.Errorf("consensus query failed: %w", err)
+	}
+
+	fmt.Println("Provider votes:")
+	for _, vote := range result.Votes {
+		if vote.Err != nil {
+			fmt.Printf("  %-10s error: %v\n", vote.Provider, vote.Err)
+			continue
+		}
+		fmt.Printf("  %-10s %s\n", vote.Provider, vote.Response.Value)
+		cacheResearch(requestID, question, vote.Provider, modelByProvider[vote.Provider], vote.Response)
+	}
+	fmt.Printf("Confidence: %.0f%%\n", result.Confidence*100)
+	fmt.Printf("Source agreement: %.0f%%\n", result.SourceAgreement*100)
+	fmt.Println()
+
+	if !result.Agreed {
+		utils.PrintWarning("Providers disagree beyond the configured threshold - refusing to auto-propose")
+		utils.PrintInfo("Review the votes above and propose manually if you're confident in a value")
+		return nil, nil
+	}
+
+	return &search_agent.OracleResponse{
+		Value:      result.Value,
+		Sources:    result.Sources,
+		Votes:      result.Votes,
+		Confidence: result.Confidence,
+	}, nil
+}
+
 // isValidNumber checks if a string represents a valid number
 // Accepts: integers, decimals with period, negative numbers
 // Rejects: anything with non-numeric characters (including currency symbols, commas, text)
@@ -269,7 +424,8 @@ func NewProposeResolveCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
 			// Execute transaction
@@ -1,13 +1,20 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"goo-cli/internal/config"
+	"goo-cli/internal/gnoabi"
 	"goo-cli/internal/gnokey"
+	"goo-cli/internal/index"
 	"goo-cli/internal/utils"
+	"goo-cli/pkg/types"
 )
 
 // NewQueryCmd creates the query command
@@ -21,6 +28,48 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(NewQueryResultCmd())
 	cmd.AddCommand(NewQueryParamsCmd())
 	cmd.AddCommand(NewQueryListCmd())
+	cmd.AddCommand(NewQueryGasCmd())
+	cmd.AddCommand(NewQueryDisputeCmd())
+
+	return cmd
+}
+
+// NewQueryGasCmd reports the current adaptive gas estimate
+func NewQueryGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas",
+		Short: "Show the current adaptive gas fee estimate",
+		Long:  "Sample recent block gas utilization and report the fee that '--gas auto' would use right now, without submitting a transaction",
+		Example: `  goo query gas`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyOverride, _ := cmd.Flags().GetString("key")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			baseFee, err := utils.ParseUgnotAmount(cfg.GasFee)
+			if err != nil {
+				return fmt.Errorf("failed to parse configured gas_fee %q: %w", cfg.GasFee, err)
+			}
+
+			estimate, err := gnokey.EstimateGas(cfg.Remote, int(cfg.GasSampleBlocks), baseFee)
+			if err != nil {
+				return err
+			}
+
+			utils.PrintSection("Adaptive Gas Estimate")
+			utils.PrintKeyValue("  Sampled Blocks", estimate.SampledBlocks)
+			utils.PrintKeyValue("  Utilization", fmt.Sprintf("%.1f%%", estimate.Utilization*100))
+			utils.PrintKeyValue("  Multiplier", fmt.Sprintf("%.2fx", estimate.Multiplier))
+			utils.PrintKeyValue("  Base Fee", utils.FormatUgnot(estimate.BaseFeeUgnot))
+			utils.PrintKeyValue("  Estimated Fee", utils.FormatUgnot(estimate.EstimatedUgnot))
+
+			if cfg.GasCeilingUgnot > 0 && estimate.EstimatedUgnot > cfg.GasCeilingUgnot {
+				utils.PrintWarning(fmt.Sprintf("Estimated fee exceeds configured ceiling of %s", utils.FormatUgnot(cfg.GasCeilingUgnot)))
+			}
+
+			return nil
+		},
+	}
 
 	return cmd
 }
@@ -38,7 +87,8 @@ func NewQueryResultCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
 			// Call as transaction since it requires realm context
@@ -55,67 +105,170 @@ func NewQueryResultCmd() *cobra.Command {
 	return cmd
 }
 
+// queryParamDefs documents the single named parameters 'query params <name>'
+// accepts, and backs the all-of-them fetch behind --json. The realm has no
+// aggregate GetParams() in this tree, so --json still costs six round trips
+// - only the per-process cache (config.Config.CacheParams) saves repeat work.
+var queryParamDefs = []struct {
+	name     string
+	label    string
+	funcName string
+}{
+	{"bond", "Bond", "GetBond"},
+	{"resolution-time", "Resolution Time", "GetResolutionTime"},
+	{"requester-reward", "Requester Reward", "GetRequesterReward"},
+	{"dispute-duration", "Dispute Duration", "GetDisputeDuration"},
+	{"reveal-duration", "Reveal Duration", "GetRevealDuration"},
+	{"vote-token-price", "Vote Token Price", "GetVoteTokenPrice"},
+}
+
 // NewQueryParamsCmd queries oracle parameters
 func NewQueryParamsCmd() *cobra.Command {
+	var asJSON bool
+
 	cmd := &cobra.Command{
-		Use:   "params",
+		Use:   "params [name]",
 		Short: "Get oracle parameters",
-		Long:  "Query all oracle configuration parameters",
-		Example: `  goo query params`,
+		Long:  "Query all oracle configuration parameters, or a single named one (bond, resolution-time, requester-reward, dispute-duration, reveal-duration, vote-token-price). --json fetches every parameter and prints them as a single types.OracleParams JSON object.",
+		Args:  cobra.MaximumNArgs(1),
+		Example: `  goo query params
+  goo query params bond
+  goo query params --json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
-			utils.PrintSection("Oracle Parameters")
+			if len(args) == 1 {
+				if asJSON {
+					return fmt.Errorf("--json fetches every parameter; it can't be combined with a single parameter name")
+				}
+				return queryOneParam(executor, args[0])
+			}
 
-			// Query each parameter
-			params := []struct {
-				name     string
-				funcName string
-			}{
-				{"Bond", "GetBond"},
-				{"Resolution Time", "GetResolutionTime"},
-				{"Requester Reward", "GetRequesterReward"},
-				{"Dispute Duration", "GetDisputeDuration"},
-				{"Reveal Duration", "GetRevealDuration"},
-				{"Vote Token Price", "GetVoteTokenPrice"},
+			if asJSON {
+				params, err := queryAllParams(cfg, executor)
+				if err != nil {
+					return err
+				}
+				data, err := json.MarshalIndent(params, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal oracle parameters: %w", err)
+				}
+				fmt.Println(string(data))
+				return nil
 			}
 
-			for _, p := range params {
+			utils.PrintSection("Oracle Parameters")
+			for _, p := range queryParamDefs {
 				result, err := executor.QueryFunction(p.funcName, []string{})
 				if err != nil {
-					utils.PrintError(fmt.Sprintf("Failed to query %s: %v", p.name, err))
+					utils.PrintError(fmt.Sprintf("Failed to query %s: %v", p.label, err))
 					continue
 				}
-				utils.PrintKeyValue(p.name, result)
+				utils.PrintKeyValue(p.label, result)
 			}
 
 			return nil
 		},
 	}
 
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Fetch every parameter and print them as a single OracleParams JSON object")
+
 	return cmd
 }
 
+// queryOneParam fetches and type-coerces a single named oracle parameter.
+func queryOneParam(executor *gnokey.TxExecutor, name string) error {
+	for _, p := range queryParamDefs {
+		if p.name != name {
+			continue
+		}
+		value, err := executor.QueryInt64(p.funcName)
+		if err != nil {
+			return fmt.Errorf("failed to query %s: %w", p.label, err)
+		}
+		utils.PrintKeyValue(p.label, value)
+		return nil
+	}
+
+	names := make([]string, len(queryParamDefs))
+	for i, p := range queryParamDefs {
+		names[i] = p.name
+	}
+	return fmt.Errorf("unknown parameter %q. Valid names are: %s", name, strings.Join(names, ", "))
+}
+
+// queryAllParams fetches every oracle parameter into a typed
+// types.OracleParams, reusing cfg's per-process cache when already warm.
+func queryAllParams(cfg *config.Config, executor *gnokey.TxExecutor) (*types.OracleParams, error) {
+	if cached, ok := cfg.CachedParams(); ok {
+		return cached, nil
+	}
+
+	bond, err := executor.QueryInt64("GetBond")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Bond: %w", err)
+	}
+	resolutionTime, err := executor.QueryInt64("GetResolutionTime")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Resolution Time: %w", err)
+	}
+	requesterReward, err := executor.QueryInt64("GetRequesterReward")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Requester Reward: %w", err)
+	}
+	disputeDuration, err := executor.QueryInt64("GetDisputeDuration")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Dispute Duration: %w", err)
+	}
+	revealDuration, err := executor.QueryInt64("GetRevealDuration")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Reveal Duration: %w", err)
+	}
+	voteTokenPrice, err := executor.QueryInt64("GetVoteTokenPrice")
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Vote Token Price: %w", err)
+	}
+
+	params := &types.OracleParams{
+		Bond:            bond,
+		ResolutionTime:  resolutionTime,
+		RequesterReward: requesterReward,
+		DisputeDuration: disputeDuration,
+		RevealDuration:  revealDuration,
+		VoteTokenPrice:  voteTokenPrice,
+	}
+	cfg.CacheParams(params)
+	return params, nil
+}
+
 // NewQueryListCmd lists requests with their states
 func NewQueryListCmd() *cobra.Command {
-	var stateFilter string
+	var (
+		stateFilter string
+		page        int
+		limit       int
+		pageKey     string
+		requester   string
+		proposer    string
+		yesno       bool
+		since       string
+		local       bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all requests with their states",
-		Long:  "Query and display all requests with their current states",
+		Long:  "Query and display all requests with their current states. --requester, --proposer, --yesno, and --since are applied client-side to every fetched request before --page/--limit slice out the page, since the realm doesn't expose those as native query predicates. --local answers entirely from the 'goo index sync' cache instead of the chain.",
 		Example: `  goo query list
-  goo query list --state Proposed`,
+  goo query list --state Proposed
+  goo query list --limit 20 --page 2
+  goo query list --proposer g1abcdef... --since 24h
+  goo query list --local --state Disputed`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			keyOverride, _ := cmd.Flags().GetString("key")
-			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
-			executor := gnokey.NewExecutor(cfg, verbose)
-
-			// Validate state filter if provided
 			if stateFilter != "" {
 				validStates := []string{"Requested", "Proposed", "Disputed", "Resolved"}
 				isValid := false
@@ -130,6 +283,50 @@ func NewQueryListCmd() *cobra.Command {
 				}
 			}
 
+			var sinceCutoff time.Time
+			if since != "" {
+				d, err := time.ParseDuration(since)
+				if err != nil {
+					return fmt.Errorf("invalid --since duration %q: %w", since, err)
+				}
+				sinceCutoff = time.Now().Add(-d)
+			}
+
+			if pageKey != "" {
+				p, err := strconv.Atoi(pageKey)
+				if err != nil {
+					return fmt.Errorf("invalid --page-key %q: %w", pageKey, err)
+				}
+				page = p
+			}
+			if page < 1 {
+				page = 1
+			}
+			if limit < 1 {
+				limit = 20
+			}
+
+			if local {
+				idx, err := openLocalIndex()
+				if err != nil {
+					return err
+				}
+				requests := idx.List(index.Filter{
+					State:     stateFilter,
+					Requester: requester,
+					Proposer:  proposer,
+					YesNo:     yesno,
+					Since:     sinceCutoff,
+				})
+				return printRequestPage(requests, stateFilter, page, limit)
+			}
+
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
 			// Query request IDs based on filter
 			var queryFunc string
 			var queryArgs []string
@@ -146,10 +343,10 @@ func NewQueryListCmd() *cobra.Command {
 				return err
 			}
 
-			// Parse the request IDs from the query result
-			requestIDs, err := utils.ParseStringArrayFromQuery(result)
+			// Decode the request IDs from the query result
+			requestIDs, err := gnoabi.DecodeStringSlice(result)
 			if err != nil {
-				return fmt.Errorf("failed to parse request IDs: %w", err)
+				return fmt.Errorf("failed to decode request IDs: %w", err)
 			}
 
 			if len(requestIDs) == 0 {
@@ -161,45 +358,100 @@ func NewQueryListCmd() *cobra.Command {
 				return nil
 			}
 
-			// Print header
-			if stateFilter != "" {
-				utils.PrintSuccess(fmt.Sprintf("Requests (filtered by state: %s)", stateFilter))
-			} else {
-				utils.PrintSuccess("All Requests")
-			}
-			fmt.Println()
-			fmt.Printf("%-12s %-50s %-15s\n", "Request ID", "Question", "State")
-			fmt.Println(fmt.Sprintf("%s %s %s", "------------", "--------------------------------------------------", "---------------"))
-
-			// Query and display details for each request
+			// The realm has no GetRequestsPage-style endpoint in this tree,
+			// so this is still an N+1 GetRequest per ID; --requester,
+			// --proposer, --yesno, and --since are then applied here over
+			// the fully-fetched set before slicing out the requested page.
+			requests := make([]*types.Request, 0, len(requestIDs))
 			for _, id := range requestIDs {
-				// Get full request to extract question
 				requestResult, err := executor.QueryFunction("GetRequest", []string{id})
 				if err != nil {
-					fmt.Printf("%-12s %-50s %-15s\n", id, "Error", "Error")
 					continue
 				}
-
-				// Parse request to get question and state
-				req, err := utils.ParseDataRequestFromQuery(requestResult)
+				req, err := gnoabi.DecodeRequest(requestResult)
 				if err != nil {
-					fmt.Printf("%-12s %-50s %-15s\n", id, "Parse Error", "Error")
 					continue
 				}
-
-				// Truncate question if too long
-				question := utils.TruncateString(req.AncillaryData, 50)
-				fmt.Printf("%-12s %-50s %-15s\n", id, question, req.State)
+				if requester != "" && req.Requester != requester {
+					continue
+				}
+				if proposer != "" && req.Proposer != proposer {
+					continue
+				}
+				if yesno && !req.YesNoQuestion {
+					continue
+				}
+				if !sinceCutoff.IsZero() && req.CreatedAt.Before(sinceCutoff) {
+					continue
+				}
+				requests = append(requests, req)
 			}
 
-			fmt.Println()
-			utils.PrintInfo(fmt.Sprintf("Total: %d request(s)", len(requestIDs)))
-
-			return nil
+			return printRequestPage(requests, stateFilter, page, limit)
 		},
 	}
 
 	cmd.Flags().StringVar(&stateFilter, "state", "", "Filter by state: Requested, Proposed, Disputed, Resolved")
+	cmd.Flags().IntVar(&page, "page", 1, "Page number to display (1-indexed)")
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of requests per page")
+	cmd.Flags().StringVar(&pageKey, "page-key", "", "Opaque page token printed by the previous page's footer (overrides --page)")
+	cmd.Flags().StringVar(&requester, "requester", "", "Filter to requests created by this address")
+	cmd.Flags().StringVar(&proposer, "proposer", "", "Filter to requests proposed by this address")
+	cmd.Flags().BoolVar(&yesno, "yesno", false, "Only show yes/no questions")
+	cmd.Flags().StringVar(&since, "since", "", "Only show requests created within this duration (e.g. 24h, 30m)")
+	cmd.Flags().BoolVar(&local, "local", false, "Answer from the local 'goo index sync' cache instead of the chain")
 
 	return cmd
 }
+
+// printRequestPage slices requests down to the requested page and prints
+// them in the 'query list' table format, shared by both the chain-backed
+// and --local code paths so they render identically.
+func printRequestPage(requests []*types.Request, stateFilter string, page, limit int) error {
+	if len(requests) == 0 {
+		if stateFilter != "" {
+			utils.PrintInfo(fmt.Sprintf("No requests found with state: %s", stateFilter))
+		} else {
+			utils.PrintInfo("No requests found")
+		}
+		return nil
+	}
+
+	total := len(requests)
+	start := (page - 1) * limit
+	if start > total {
+		start = total
+	}
+	end := start + limit
+	if end > total {
+		end = total
+	}
+	pageRequests := requests[start:end]
+
+	if len(pageRequests) == 0 {
+		utils.PrintInfo("No requests match the given filters")
+		return nil
+	}
+
+	if stateFilter != "" {
+		utils.PrintSuccess(fmt.Sprintf("Requests (filtered by state: %s)", stateFilter))
+	} else {
+		utils.PrintSuccess("All Requests")
+	}
+	fmt.Println()
+	fmt.Printf("%-12s %-50s %-15s\n", "Request ID", "Question", "State")
+	fmt.Println(fmt.Sprintf("%s %s %s", "------------", "--------------------------------------------------", "---------------"))
+
+	for _, req := range pageRequests {
+		question := utils.TruncateString(req.AncillaryData, 50)
+		fmt.Printf("%-12s %-50s %-15s\n", req.ID, question, req.State)
+	}
+
+	fmt.Println()
+	utils.PrintInfo(fmt.Sprintf("Showing %d-%d of %d request(s)", start+1, end, total))
+	if end < total {
+		utils.PrintInfo(fmt.Sprintf("Next page: --page-key %d", page+1))
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/utils"
+	"goo-cli/pkg/gooclient"
+	"goo-cli/pkg/types"
+)
+
+// NewQueryDisputeCmd mounts read-only dispute lookups under 'query dispute',
+// modeled after the Cosmos SDK gov module's 'query votes'/'query tally':
+// info for the raw struct, tally for the current vote outcome, and votes
+// for the per-voter commit/reveal status. All three read the same
+// GetDispute data 'dispute get' already uses - the realm doesn't expose
+// separate tally/vote-listing endpoints, so there's nothing further to
+// query once types.Dispute has been decoded.
+func NewQueryDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute",
+		Short: "Query dispute state",
+		Long:  "Read-only lookups for a dispute's info, vote tally, and per-voter commits",
+	}
+
+	cmd.AddCommand(NewQueryDisputeInfoCmd())
+	cmd.AddCommand(NewQueryDisputeTallyCmd())
+	cmd.AddCommand(NewQueryDisputeVotesCmd())
+
+	return cmd
+}
+
+// NewQueryDisputeInfoCmd prints a dispute's full details
+func NewQueryDisputeInfoCmd() *cobra.Command {
+	var local bool
+
+	cmd := &cobra.Command{
+		Use:   "info <request-id>",
+		Short: "Show a dispute's full details",
+		Long:  "Query and display a dispute's vote/reveal windows, resolution status, and vote tally",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo query dispute info 0000001
+  goo query dispute info 0000001 --local`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requestID := args[0]
+
+			dispute, err := queryDisputeForCmd(cmd, requestID, local)
+			if err != nil {
+				return err
+			}
+
+			utils.PrintSection(fmt.Sprintf("Dispute %s", dispute.RequestID))
+			fmt.Println()
+			utils.PrintKeyValue("  Disputer", dispute.Disputer)
+			utils.PrintKeyValue("  Disputed At", utils.FormatTimeRFC3339(dispute.DisputeInitiatedAt))
+			utils.PrintKeyValue("  Vote End", fmt.Sprintf("%s (%s)", utils.FormatTimeRFC3339(dispute.VoteEndTime), timeUntilLabel(dispute.VoteEndTime)))
+			utils.PrintKeyValue("  Reveal End", fmt.Sprintf("%s (%s)", utils.FormatTimeRFC3339(dispute.RevealEndTime), timeUntilLabel(dispute.RevealEndTime)))
+			if dispute.Resolved {
+				utils.PrintKeyValue("  Resolved", fmt.Sprintf("yes, winning value %d", dispute.WinningValue))
+			} else {
+				utils.PrintKeyValue("  Resolved", "no")
+			}
+			utils.PrintKeyValue("  Total Votes", dispute.TotalVotes)
+			utils.PrintKeyValue("  Votes For", dispute.VotesFor)
+			utils.PrintKeyValue("  Votes Against", dispute.VotesAgainst)
+			fmt.Println()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&local, "local", false, "Answer from the local 'goo index sync' cache instead of the chain")
+
+	return cmd
+}
+
+// NewQueryDisputeTallyCmd prints a dispute's current vote outcome
+func NewQueryDisputeTallyCmd() *cobra.Command {
+	var local bool
+
+	cmd := &cobra.Command{
+		Use:   "tally <request-id>",
+		Short: "Show a dispute's current vote tally and outcome",
+		Long:  "Query the dispute's revealed-vote counts and the outcome they currently imply. The outcome is provisional until every vote is revealed and the dispute is resolved on-chain.",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo query dispute tally 0000001
+  goo query dispute tally 0000001 --local`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requestID := args[0]
+
+			dispute, err := queryDisputeForCmd(cmd, requestID, local)
+			if err != nil {
+				return err
+			}
+
+			revealed := dispute.VotesFor + dispute.VotesAgainst
+			utils.PrintSection(fmt.Sprintf("Dispute Tally %s", dispute.RequestID))
+			fmt.Println()
+			utils.PrintKeyValue("  Total Votes", dispute.TotalVotes)
+			utils.PrintKeyValue("  Revealed Votes", revealed)
+			utils.PrintKeyValue("  Unrevealed Votes", dispute.TotalVotes-revealed)
+			utils.PrintKeyValue("  Votes For", dispute.VotesFor)
+			utils.PrintKeyValue("  Votes Against", dispute.VotesAgainst)
+
+			if dispute.Resolved {
+				utils.PrintKeyValue("  Outcome", fmt.Sprintf("resolved, winning value %d", dispute.WinningValue))
+			} else if revealed == 0 {
+				utils.PrintKeyValue("  Outcome", "no votes revealed yet")
+			} else if dispute.VotesFor > dispute.VotesAgainst {
+				utils.PrintKeyValue("  Outcome", "leaning for (not yet resolved)")
+			} else if dispute.VotesAgainst > dispute.VotesFor {
+				utils.PrintKeyValue("  Outcome", "leaning against (not yet resolved)")
+			} else {
+				utils.PrintKeyValue("  Outcome", "tied (not yet resolved)")
+			}
+			fmt.Println()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&local, "local", false, "Answer from the local 'goo index sync' cache instead of the chain")
+
+	return cmd
+}
+
+// NewQueryDisputeVotesCmd lists a dispute's per-voter commits
+func NewQueryDisputeVotesCmd() *cobra.Command {
+	var local bool
+
+	cmd := &cobra.Command{
+		Use:   "votes <request-id>",
+		Short: "List a dispute's per-voter commits",
+		Long:  "Query every voter's commit hash and, once they've revealed, the value they voted for",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo query dispute votes 0000001
+  goo query dispute votes 0000001 --local`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requestID := args[0]
+
+			dispute, err := queryDisputeForCmd(cmd, requestID, local)
+			if err != nil {
+				return err
+			}
+
+			if len(dispute.Votes) == 0 {
+				utils.PrintInfo(fmt.Sprintf("No votes committed yet on dispute %s", requestID))
+				return nil
+			}
+
+			pastReveal := time.Now().After(dispute.RevealEndTime)
+
+			utils.PrintSection(fmt.Sprintf("Dispute Votes %s", dispute.RequestID))
+			fmt.Println()
+			fmt.Printf("%-46s %-66s %-10s\n", "Voter", "Hash", "Value")
+			fmt.Println(fmt.Sprintf("%s %s %s", "----------------------------------------------", "------------------------------------------------------------------", "----------"))
+			for _, v := range dispute.Votes {
+				value := "(not revealed)"
+				if v.Revealed {
+					value = fmt.Sprintf("%d", v.Value)
+				} else if pastReveal {
+					value = "(never revealed)"
+				}
+				fmt.Printf("%-46s %-66s %-10s\n", utils.FormatAddress(v.Voter), v.Hash, value)
+			}
+			fmt.Println()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&local, "local", false, "Answer from the local 'goo index sync' cache instead of the chain")
+
+	return cmd
+}
+
+// queryDisputeForCmd resolves the usual --key/--verbose/--profile flags and
+// queries requestID's dispute, shared by every 'query dispute' subcommand.
+// When local is set, it's read from the 'goo index sync' cache instead.
+func queryDisputeForCmd(cmd *cobra.Command, requestID string, local bool) (*types.Dispute, error) {
+	if local {
+		idx, err := openLocalIndex()
+		if err != nil {
+			return nil, err
+		}
+		dispute, ok := idx.GetDispute(requestID)
+		if !ok {
+			return nil, fmt.Errorf("no cached dispute for request %s; run 'goo index sync' first", requestID)
+		}
+		return dispute, nil
+	}
+
+	keyOverride, _ := cmd.Flags().GetString("key")
+	verbose, _ := cmd.Flags().GetBool("verbose")
+	profileOverride, _ := cmd.Flags().GetString("profile")
+	cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+	client := gooclient.New(clientContextFrom(cfg, ""))
+	client.Verbose(verbose)
+
+	return client.QueryDispute(requestID)
+}
+
+// timeUntilLabel formats the remaining (or elapsed) time until t for
+// display alongside its absolute timestamp.
+func timeUntilLabel(t time.Time) string {
+	d := time.Until(t)
+	if d < 0 {
+		return fmt.Sprintf("%s ago", utils.FormatDuration(-d))
+	}
+	return fmt.Sprintf("in %s", utils.FormatDuration(d))
+}
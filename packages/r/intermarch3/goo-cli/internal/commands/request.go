@@ -6,8 +6,11 @@ import (
 	"github.com/spf13/cobra"
 
 	"goo-cli/internal/config"
+	"goo-cli/internal/gnoabi"
 	"goo-cli/internal/gnokey"
 	"goo-cli/internal/utils"
+	"goo-cli/pkg/gooclient"
+	"goo-cli/pkg/types"
 )
 
 // NewRequestCmd creates the request command
@@ -28,10 +31,11 @@ func NewRequestCmd() *cobra.Command {
 // NewRequestCreateCmd creates a new data request
 func NewRequestCreateCmd() *cobra.Command {
 	var (
-		question string
-		yesno    bool
-		deadline string
-		reward   int64
+		question     string
+		yesno        bool
+		deadline     string
+		reward       int64
+		evidenceFile string
 	)
 
 	cmd := &cobra.Command{
@@ -52,8 +56,10 @@ func NewRequestCreateCmd() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
-			executor := gnokey.NewExecutor(cfg, verbose)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			client := gooclient.New(clientContextFrom(cfg, ""))
+			client.Verbose(verbose)
 
 			// Parse deadline
 			deadlineTime, err := utils.ParseDeadline(deadline)
@@ -64,24 +70,30 @@ func NewRequestCreateCmd() *cobra.Command {
 			// If reward is 0, query the default requester reward from contract
 			if reward == 0 {
 				utils.PrintInfo("Querying default requester reward from contract...")
-				reward, err = executor.QueryInt64("GetRequesterReward")
+				reward, err = client.QueryRequesterReward()
 				if err != nil {
 					return fmt.Errorf("failed to query requester reward: %w", err)
 				}
 				utils.PrintInfo(fmt.Sprintf("Default reward: %d ugnot", reward))
 			}
 
-			// Prepare function arguments
-			funcArgs := []string{
-				question,                                // ancillaryData
-				utils.FormatBool(yesno),                // yesNoQuestion
-				fmt.Sprintf("%d", deadlineTime.Unix()), // deadline
+			// Bind off-chain evidence to the question, if requested
+			if evidenceFile != "" {
+				executor := gnokey.NewExecutor(cfg, verbose)
+				suffix, err := buildEvidenceSuffix(executor, evidenceFile)
+				if err != nil {
+					return err
+				}
+				question = question + " " + suffix
 			}
 
-			sendAmount := fmt.Sprintf("%dugnot", reward)
+			waitTarget, waitTimeout, waitOK, err := parseWaitFlags(cmd)
+			if err != nil {
+				return err
+			}
 
 			// Execute transaction
-			if err := executor.CallFunction("RequestData", funcArgs, sendAmount); err != nil {
+			if err := client.Request(question, yesno, deadlineTime, reward); err != nil {
 				return err
 			}
 
@@ -95,6 +107,20 @@ func NewRequestCreateCmd() *cobra.Command {
 			utils.PrintInfo(fmt.Sprintf("Deadline: %s", utils.FormatTimeRFC3339(deadlineTime)))
 			utils.PrintInfo(fmt.Sprintf("Reward sent: %d ugnot", reward))
 
+			if waitOK {
+				executor := gnokey.NewExecutor(cfg, verbose)
+				requestIDsResult, err := executor.QueryFunction("GetRequestsIds", []string{})
+				if err != nil {
+					return fmt.Errorf("failed to look up the new request's ID to wait on: %w", err)
+				}
+				ids, err := gnoabi.DecodeStringSlice(requestIDsResult)
+				if err != nil || len(ids) == 0 {
+					return fmt.Errorf("failed to determine the new request's ID to wait on")
+				}
+				requestID := ids[len(ids)-1]
+				return WaitForState(cmd.Context(), executor, requestID, waitTarget, waitTimeout)
+			}
+
 			return nil
 		},
 	}
@@ -103,6 +129,8 @@ func NewRequestCreateCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&yesno, "yesno", false, "Set to true for yes/no questions (default: numeric)")
 	cmd.Flags().StringVar(&deadline, "deadline", "", "Deadline in RFC3339 format (e.g., 2025-10-28T12:00:00Z)")
 	cmd.Flags().Int64Var(&reward, "reward", 0, "Reward amount in ugnot (default: query from contract)")
+	cmd.Flags().StringVar(&evidenceFile, "evidence-file", "", "Sign this file and append evidence_sha256=<hex>;sig=<hex> to the question")
+	addWaitFlags(cmd)
 
 	cmd.MarkFlagRequired("question")
 	cmd.MarkFlagRequired("deadline")
@@ -122,26 +150,16 @@ func NewRequestGetCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
-			executor := gnokey.NewExecutor(cfg, verbose)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			client := gooclient.New(clientContextFrom(cfg, ""))
+			client.Verbose(verbose)
 
-			// Query the request
-			result, err := executor.QueryFunction("GetRequest", []string{requestID})
+			req, err := client.QueryRequest(requestID)
 			if err != nil {
 				return err
 			}
 
-			// Parse the request data
-			req, err := utils.ParseDataRequestFromQuery(result)
-			if err != nil {
-				// If parsing fails, show raw output in verbose mode
-				if verbose {
-					utils.PrintError(fmt.Sprintf("Failed to parse request: %v", err))
-					fmt.Println(result)
-				}
-				return fmt.Errorf("failed to parse request data: %w", err)
-			}
-
 			// Display request information in a clean format
 			utils.PrintSection(fmt.Sprintf("Request %s", req.ID))
 			fmt.Println()
@@ -150,15 +168,15 @@ func NewRequestGetCmd() *cobra.Command {
 			fmt.Println("Basic Information:")
 			utils.PrintKeyValue("  Request ID", req.ID)
 			utils.PrintKeyValue("  State", req.State)
-			utils.PrintKeyValue("  Creator", req.Creator)
+			utils.PrintKeyValue("  Requester", req.Requester)
 			utils.PrintKeyValue("  Question", req.AncillaryData)
 			if req.YesNoQuestion {
 				utils.PrintKeyValue("  Type", "Yes/No Question")
 			} else {
 				utils.PrintKeyValue("  Type", "Numeric")
 			}
-			// Note: Timestamps/Deadlines are stored as time.Time and can't be parsed from query output
-			// To display them, the contract would need getter functions that return Unix timestamps
+			utils.PrintKeyValue("  Created At", utils.FormatTimeRFC3339(req.CreatedAt))
+			utils.PrintKeyValue("  Deadline", utils.FormatTimeRFC3339(req.Deadline))
 
 			// Proposal Information
 			fmt.Println()
@@ -182,10 +200,11 @@ func NewRequestGetCmd() *cobra.Command {
 			}
 
 			// Resolution Information
-			if req.State == "Resolved" {
+			if req.State == types.StateResolved {
 				fmt.Println()
 				fmt.Println("Resolution:")
 				utils.PrintKeyValue("  Winning Value", req.WinningValue)
+				utils.PrintKeyValue("  Resolution Time", utils.FormatTimeRFC3339(req.ResolutionTime))
 			}
 
 			fmt.Println()
@@ -210,7 +229,8 @@ func NewRequestRetrieveFundCmd() *cobra.Command {
 
 			keyOverride, _ := cmd.Flags().GetString("key")
 			verbose, _ := cmd.Flags().GetBool("verbose")
-			cfg := config.LoadWithKeyOverride(keyOverride)
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 			executor := gnokey.NewExecutor(cfg, verbose)
 
 			// Execute transaction
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/research"
+	"goo-cli/internal/utils"
+)
+
+// NewResearchCmd creates the research command
+func NewResearchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "research",
+		Short: "Inspect cached AI research",
+		Long:  "Show and verify the attested AI research runs cached under ~/.goo/research by `propose value --search`",
+	}
+
+	cmd.AddCommand(NewResearchShowCmd())
+	cmd.AddCommand(NewResearchVerifyCmd())
+
+	return cmd
+}
+
+// NewResearchShowCmd shows cached research for a request
+func NewResearchShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <request-id>",
+		Short: "Show cached AI research for a request",
+		Long:  "Display every cached AI research run for a request, most recent first, including the attestation hash a disputer can use to verify the evidence a proposer used",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo research show 0000001`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requestID := args[0]
+
+			records, err := research.ListForRequest(requestID)
+			if err != nil {
+				return fmt.Errorf("failed to list research: %w", err)
+			}
+			if len(records) == 0 {
+				utils.PrintInfo(fmt.Sprintf("No cached research found for request %s", requestID))
+				return nil
+			}
+
+			for _, rec := range records {
+				utils.PrintSection(fmt.Sprintf("Research for %s @ %s", rec.RequestID, utils.FormatTimeRFC3339(rec.Timestamp)))
+				fmt.Println()
+				utils.PrintKeyValue("  Provider", rec.Provider)
+				utils.PrintKeyValue("  Model", rec.Model)
+				utils.PrintKeyValue("  Question", rec.Question)
+				utils.PrintKeyValue("  Value", rec.NormalizedValue)
+				utils.PrintKeyValue("  Attestation Hash", rec.AttestationHash)
+				if len(rec.Sources) > 0 {
+					fmt.Println("  Sources:")
+					for i, src := range rec.Sources {
+						fmt.Printf("    %d. %s\n", i+1, src)
+					}
+				}
+				fmt.Println()
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewResearchVerifyCmd verifies a cached research file's attestation hash
+func NewResearchVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <file>",
+		Short: "Verify a cached research file's attestation hash",
+		Long:  "Recompute H(question || provider || model || rawResponse) for a cached research file and confirm it matches the stored attestation hash",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo research verify ~/.goo/research/0000001-1753500000.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+
+			rec, err := research.Load(path)
+			if err != nil {
+				return fmt.Errorf("failed to load research file: %w", err)
+			}
+
+			utils.PrintKeyValue("  Request ID", rec.RequestID)
+			utils.PrintKeyValue("  Provider", rec.Provider)
+			utils.PrintKeyValue("  Model", rec.Model)
+			utils.PrintKeyValue("  Attestation Hash", rec.AttestationHash)
+			fmt.Println()
+
+			if research.Verify(rec) {
+				utils.PrintSuccess("Attestation hash matches - this record is untampered")
+			} else {
+				utils.PrintError("Attestation hash mismatch - this record does not match its claimed question/provider/model/response")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -5,8 +5,9 @@ import (
 )
 
 var (
-	keyOverride string
-	verbose     bool
+	keyOverride     string
+	verbose         bool
+	profileOverride string
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -26,6 +27,7 @@ func init() {
 	// Add global flags
 	rootCmd.PersistentFlags().StringVarP(&keyOverride, "key", "k", "", "Override the key name from config")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
+	rootCmd.PersistentFlags().StringVarP(&profileOverride, "profile", "p", "", "Target this named config profile instead of current_profile")
 
 	// Add all subcommands
 	rootCmd.AddCommand(NewConfigCmd())
@@ -34,5 +36,12 @@ func init() {
 	rootCmd.AddCommand(NewDisputeCmd())
 	rootCmd.AddCommand(NewVoteCmd())
 	rootCmd.AddCommand(NewQueryCmd())
+	rootCmd.AddCommand(NewIndexCmd())
 	rootCmd.AddCommand(NewAdminCmd())
+	rootCmd.AddCommand(NewResearchCmd())
+	rootCmd.AddCommand(NewServeCmd())
+	rootCmd.AddCommand(NewSignCmd())
+	rootCmd.AddCommand(NewVerifyCmd())
+	rootCmd.AddCommand(NewBotCmd())
+	rootCmd.AddCommand(NewTxCmd())
 }
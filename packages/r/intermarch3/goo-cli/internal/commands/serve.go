@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/gqlserver"
+	"goo-cli/internal/metrics"
+	"goo-cli/internal/utils"
+)
+
+// version is the goo CLI build version, published on the goo_info metric.
+// There is no build-time ldflags injection in this repo yet, so it is a
+// constant rather than a variable.
+const version = "dev"
+
+// NewServeCmd creates the serve command
+func NewServeCmd() *cobra.Command {
+	var allowMutations bool
+	var withMetrics bool
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Start a GraphQL server over oracle requests and disputes",
+		Long:  "Start a long-running HTTP server exposing a GraphQL endpoint (with playground), backed by an in-memory TTL cache in front of gnokey, plus a websocket subscription endpoint that polls for changes.",
+		Example: `  goo serve
+  goo serve --allow-mutations --key mykey
+  goo serve --metrics`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
+			server, err := gqlserver.NewServer(cfg, executor, allowMutations)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if withMetrics {
+				metrics.SetInfo(version, cfg.ChainID, cfg.RealmPath)
+				interval := time.Duration(cfg.MetricsScrapeIntervalSeconds) * time.Second
+				go metrics.NewCollector(executor, interval).Run(ctx)
+
+				metricsServer := &http.Server{Addr: cfg.MetricsListenAddr, Handler: metrics.Handler()}
+				go func() {
+					<-ctx.Done()
+					metricsServer.Close()
+				}()
+				go func() {
+					utils.PrintInfo("Serving Prometheus metrics on " + cfg.MetricsListenAddr + "/metrics")
+					if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+						utils.PrintWarning("metrics server stopped: " + err.Error())
+					}
+				}()
+			}
+
+			utils.PrintInfo("Starting GraphQL server (Ctrl+C to stop)...")
+			return server.Run(ctx)
+		},
+	}
+
+	cmd.Flags().BoolVar(&allowMutations, "allow-mutations", false, "Enable requestData/disputeData GraphQL mutations (signed with --key, or the configured key)")
+	cmd.Flags().BoolVar(&withMetrics, "metrics", false, "Also serve Prometheus metrics (see config metrics_listen_addr)")
+
+	return cmd
+}
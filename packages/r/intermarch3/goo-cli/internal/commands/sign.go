@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+)
+
+// NewSignCmd signs an arbitrary payload file with the active gnokey
+// identity, for binding off-chain evidence (e.g. a JSON bundle referenced
+// from a request's AncillaryData) to an on-chain account.
+func NewSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign <file>",
+		Short: "Sign an arbitrary file with your gnokey identity",
+		Long:  "Sign a file's contents with your gnokey, producing a signature and public key that counter-parties can verify with 'goo verify' without needing to trust a central host for the evidence.",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo sign evidence.json --key mykey`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			payload, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", args[0], err)
+			}
+
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
+			sigHex, pubkeyHex, err := executor.SignBytes(payload)
+			if err != nil {
+				return err
+			}
+
+			hash := sha256.Sum256(payload)
+
+			utils.PrintSuccess("Payload signed!")
+			utils.PrintKeyValue("File", args[0])
+			utils.PrintKeyValue("SHA256", hex.EncodeToString(hash[:]))
+			utils.PrintKeyValue("Signature", sigHex)
+			if pubkeyHex != "" {
+				utils.PrintKeyValue("PubKey", pubkeyHex)
+			}
+			utils.PrintInfo(fmt.Sprintf("Share the file, signature, and your address; verify with: goo verify %s <signature> <address>", args[0]))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// buildEvidenceSuffix hashes evidenceFile, signs it with executor's key, and
+// returns an "evidence_sha256=<hex>;sig=<hex>" suffix so counter-parties can
+// reproduce the hash and verify the signature independently of this CLI.
+// 'goo request create' appends it to the question; DisputeData takes no
+// ancillary text, so 'goo dispute create' only signs and prints it.
+func buildEvidenceSuffix(executor *gnokey.TxExecutor, evidenceFile string) (string, error) {
+	payload, err := os.ReadFile(evidenceFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read evidence file %s: %w", evidenceFile, err)
+	}
+
+	hash := sha256.Sum256(payload)
+	hashHex := hex.EncodeToString(hash[:])
+
+	sigHex, _, err := executor.SignBytes(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign evidence file: %w", err)
+	}
+
+	utils.PrintInfo(fmt.Sprintf("Evidence file %s hashed and signed (sha256=%s)", evidenceFile, hashHex))
+	utils.PrintInfo("Counter-parties can reproduce the hash with 'sha256sum " + evidenceFile + "' and verify the signature with 'goo verify'")
+
+	return fmt.Sprintf("evidence_sha256=%s;sig=%s", hashHex, sigHex), nil
+}
+
+// NewVerifyCmd verifies a signature produced by 'goo sign' against a
+// bech32 address.
+func NewVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify <file> <signature-hex> <address>",
+		Short: "Verify a signature over a file against a gno address",
+		Long:  "Verify that <signature-hex> is a valid signature by <address> over <file>'s contents, recovering and comparing the signing address.",
+		Args:  cobra.ExactArgs(3),
+		Example: `  goo verify evidence.json a1b2c3... g1abcdef...`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filePath, sigHex, address := args[0], args[1], args[2]
+
+			payload, err := os.ReadFile(filePath)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", filePath, err)
+			}
+
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+
+			valid, err := executor.VerifyBytes(payload, sigHex, address)
+			if err != nil {
+				return err
+			}
+
+			if valid {
+				utils.PrintSuccess(fmt.Sprintf("Valid signature by %s over %s", address, filePath))
+			} else {
+				utils.PrintError(fmt.Sprintf("Invalid signature: %s was not signed by %s", filePath, address))
+				return fmt.Errorf("signature verification failed")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
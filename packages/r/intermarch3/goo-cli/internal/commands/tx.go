@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+	"goo-cli/internal/vault"
+)
+
+// pendingSidecar is the sidecar written alongside an unsigned tx file built
+// by an --offline commit/reveal command. It carries whatever the vault
+// would otherwise have stored, so goo tx broadcast can import the vote into
+// ~/.goo/votes once the transaction actually lands on chain.
+type pendingSidecar struct {
+	RequestID      string    `json:"request_id"`
+	Value          string    `json:"value"`
+	Salt           string    `json:"salt"`
+	Hash           string    `json:"hash"`
+	RevealDeadline time.Time `json:"reveal_deadline"`
+}
+
+// sidecarPath derives the pending-vote sidecar path from an unsigned tx
+// file's path, by convention.
+func sidecarPath(txPath string) string {
+	return strings.TrimSuffix(txPath, ".json") + ".pending.json"
+}
+
+// writeSidecar persists sc next to the unsigned tx at txPath.
+func writeSidecar(txPath string, sc pendingSidecar) error {
+	data, err := json.MarshalIndent(sc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal pending vote sidecar: %w", err)
+	}
+	if err := os.WriteFile(sidecarPath(txPath), data, 0600); err != nil {
+		return fmt.Errorf("failed to write pending vote sidecar: %w", err)
+	}
+	return nil
+}
+
+// readSidecar loads the pending-vote sidecar for txPath, if one exists.
+func readSidecar(txPath string) (*pendingSidecar, error) {
+	data, err := os.ReadFile(sidecarPath(txPath))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pending vote sidecar: %w", err)
+	}
+	var sc pendingSidecar
+	if err := json.Unmarshal(data, &sc); err != nil {
+		return nil, fmt.Errorf("failed to parse pending vote sidecar: %w", err)
+	}
+	return &sc, nil
+}
+
+// NewTxCmd creates the tx command, the air-gapped counterpart to the
+// commands that build, sign, and broadcast transactions all in one step.
+// It lets the unsigned tx produced by an --offline commit/reveal travel to
+// a machine holding the signing key without that key ever touching the
+// network-connected host.
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx",
+		Short: "Sign and broadcast transactions built offline",
+		Long:  "Complete the air-gapped signing workflow: sign an unsigned tx file with a locally available key, then broadcast the signed file from a network-connected machine",
+	}
+
+	cmd.AddCommand(NewTxSignCmd())
+	cmd.AddCommand(NewTxBroadcastCmd())
+
+	return cmd
+}
+
+// NewTxSignCmd signs an unsigned tx file in place using a local key. It
+// makes no network call: the account number and sequence were baked into the
+// file when it was built, and the chain ID passed to gnokey comes from the
+// active profile, so it has to match the chain the file was built for.
+func NewTxSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign <file>",
+		Short: "Sign an unsigned tx file",
+		Long:  "Sign an unsigned tx file produced by an --offline commit/reveal command, using a key available on this machine. Safe to run air-gapped: no network access is required.",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo tx sign req-001-vote-commit.unsigned.json --key mykey`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txPath := args[0]
+
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			if cfg.KeyName == "" {
+				return fmt.Errorf("no key name configured; pass --key <name>")
+			}
+
+			if err := gnokey.SignTxFile(txPath, cfg.KeyName, cfg.ChainID, verbose); err != nil {
+				return err
+			}
+
+			utils.PrintSuccess(fmt.Sprintf("%s signed with key %q", txPath, cfg.KeyName))
+			utils.PrintInfo(fmt.Sprintf("Broadcast from a network-connected machine with: goo tx broadcast %s", txPath))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewTxBroadcastCmd submits a signed tx file and, on success, imports any
+// pending vote sidecar into the vault so goo vote reveal still works later.
+func NewTxBroadcastCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast <file>",
+		Short: "Broadcast a signed tx file",
+		Long:  "Submit a signed tx file to the configured remote. If the file was produced by goo vote commit --offline, the committed vote is imported into the local vault on success so goo vote reveal can find it.",
+		Args:  cobra.ExactArgs(1),
+		Example: `  goo tx broadcast req-001-vote-commit.unsigned.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txPath := args[0]
+
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			output, err := gnokey.BroadcastTxFile(txPath, cfg.Remote, verbose)
+			if err != nil {
+				return err
+			}
+
+			utils.PrintSuccess("Transaction broadcast!")
+			if !verbose {
+				fmt.Println(output)
+			}
+
+			sc, err := readSidecar(txPath)
+			if err != nil {
+				utils.PrintWarning(fmt.Sprintf("Broadcast succeeded but could not read pending vote sidecar: %v", err))
+				return nil
+			}
+			if sc == nil {
+				return nil
+			}
+
+			utils.PrintInfo(fmt.Sprintf("Importing committed vote for request %s into the vault...", sc.RequestID))
+			passphrase, err := vault.PromptPassphrase("Vault passphrase (used to encrypt this vote, remember it for reveal): ")
+			if err != nil {
+				return err
+			}
+			v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+			if err != nil {
+				return fmt.Errorf("failed to open vault: %w", err)
+			}
+
+			rec := vault.Record{
+				RequestID:      sc.RequestID,
+				Value:          sc.Value,
+				Salt:           sc.Salt,
+				Hash:           sc.Hash,
+				CommittedAt:    time.Now(),
+				RevealDeadline: sc.RevealDeadline,
+			}
+			if err := v.Commit(rec, passphrase); err != nil {
+				return fmt.Errorf("vote was broadcast on-chain but failed to save to vault: %w", err)
+			}
+
+			utils.PrintSuccess(fmt.Sprintf("Request %s: vote imported into vault, reveal before %s", sc.RequestID, utils.FormatTimeRFC3339(sc.RevealDeadline)))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -2,12 +2,17 @@ package commands
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"goo-cli/internal/config"
+	"goo-cli/internal/gnoabi"
 	"goo-cli/internal/gnokey"
 	"goo-cli/internal/utils"
+	"goo-cli/internal/vault"
+	"goo-cli/pkg/gooclient"
 )
 
 // NewVoteCmd creates the vote command
@@ -22,6 +27,11 @@ func NewVoteCmd() *cobra.Command {
 	cmd.AddCommand(NewVoteBalanceCmd())
 	cmd.AddCommand(NewVoteCommitCmd())
 	cmd.AddCommand(NewVoteRevealCmd())
+	cmd.AddCommand(NewVoteListCmd())
+	cmd.AddCommand(NewVoteWatchCmd())
+	cmd.AddCommand(NewVoteRecoverCmd())
+	cmd.AddCommand(NewVoteImportMnemonicCmd())
+	cmd.AddCommand(NewVoteExportMnemonicCmd())
 
 	return cmd
 }
@@ -34,8 +44,14 @@ func NewVoteBuyTokenCmd() *cobra.Command {
 		Long:  "Purchase the initial vote token required to participate in voting",
 		Example: `  goo vote buy-token`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg := config.Load()
-			executor := gnokey.NewExecutor(cfg)
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
+			if err := applyGasFlag(cmd, executor, cfg); err != nil {
+				return err
+			}
 
 			utils.PrintWarning("Make sure to check the vote token price before submitting!")
 
@@ -51,6 +67,8 @@ func NewVoteBuyTokenCmd() *cobra.Command {
 		},
 	}
 
+	addGasFlag(cmd)
+
 	return cmd
 }
 
@@ -62,8 +80,11 @@ func NewVoteBalanceCmd() *cobra.Command {
 		Long:  "Query your current vote token balance",
 		Example: `  goo vote balance`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cfg := config.Load()
-			executor := gnokey.NewExecutor(cfg)
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			executor := gnokey.NewExecutor(cfg, verbose)
 
 			// Query balance
 			result, err := executor.QueryFunction("BalanceOfVoteToken", []string{})
@@ -83,87 +104,532 @@ func NewVoteBalanceCmd() *cobra.Command {
 
 // NewVoteCommitCmd commits a vote
 func NewVoteCommitCmd() *cobra.Command {
-	var salt string
+	var (
+		salt    string
+		offline bool
+		from    string
+		outPath string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "commit <request-id> <value>",
 		Short: "Commit a vote on a dispute",
-		Long:  "Submit a hashed vote during the voting period. The hash will be revealed later.",
+		Long:  "Submit a hashed vote during the voting period. The value and salt are stored in an encrypted vault so they survive across CLI invocations until the reveal phase. With --offline, no key needs to be present on this machine: an unsigned tx and a pending-vote sidecar are written to disk instead, to be completed with 'goo tx sign' and 'goo tx broadcast' on a machine that holds the key.",
 		Args:  cobra.ExactArgs(2),
 		Example: `  goo vote commit req-001 3500
-  goo vote commit req-001 3500 --salt my-random-salt`,
+  goo vote commit req-001 3500 --salt my-random-salt
+  goo vote commit req-001 3500 --offline --from g1abcdef...`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			requestID := args[0]
 			value := args[1]
 
-			cfg := config.Load()
-			executor := gnokey.NewExecutor(cfg)
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			gasFlag, _ := cmd.Flags().GetString("gas")
+			gasFee, err := resolveGasFee(gasFlag, cfg)
+			if err != nil {
+				return err
+			}
 
-			// Auto-generate salt if not provided
-			if salt == "" {
-				salt = utils.GenerateRandomSalt(32)
-				utils.PrintInfo(fmt.Sprintf("Auto-generated salt: %s", salt))
+			if offline {
+				if from == "" {
+					return fmt.Errorf("--offline requires --from <address>")
+				}
+				if outPath == "" {
+					outPath = fmt.Sprintf("%s-vote-commit.unsigned.json", requestID)
+				}
+
+				executor := gnokey.NewExecutor(cfg, verbose)
+				if gasFee != "" {
+					executor.GasFee = gasFee
+				}
+
+				result, err := executor.QueryFunction("GetDispute", []string{requestID})
+				if err != nil {
+					return err
+				}
+				dispute, err := gnoabi.DecodeDispute(result)
+				if err != nil {
+					return fmt.Errorf("failed to decode dispute data: %w", err)
+				}
+
+				if salt == "" {
+					salt = utils.GenerateRandomSalt(32)
+				}
+				hash := utils.GenerateVoteHash(value, salt)
+
+				if err := executor.BuildUnsignedTx("VoteOnDispute", []string{requestID, hash}, "", from, outPath); err != nil {
+					return err
+				}
+				if err := writeSidecar(outPath, pendingSidecar{
+					RequestID:      requestID,
+					Value:          value,
+					Salt:           salt,
+					Hash:           hash,
+					RevealDeadline: dispute.RevealEndTime,
+				}); err != nil {
+					return err
+				}
+
+				utils.PrintSuccess(fmt.Sprintf("Unsigned vote commit tx written to %s", outPath))
+				utils.PrintInfo(fmt.Sprintf("Hash: %s (keep the salt safe: %s is not committed to the vault until broadcast)", hash, sidecarPath(outPath)))
+				utils.PrintInfo(fmt.Sprintf("Sign it on a machine holding the key with: goo tx sign %s --key <name>", outPath))
+				utils.PrintInfo(fmt.Sprintf("Then submit it with: goo tx broadcast %s", outPath))
+
+				return nil
 			}
 
-			// Generate hash
-			hash := utils.GenerateVoteHash(value, salt)
+			client := gooclient.New(clientContextFrom(cfg, gasFee))
+			client.Verbose(verbose)
 
-			// Execute transaction
-			funcArgs := []string{requestID, hash}
-			if err := executor.CallFunction("VoteOnDispute", funcArgs, ""); err != nil {
+			passphrase, err := vault.PromptPassphrase("Vault passphrase (used to encrypt this vote, remember it for reveal): ")
+			if err != nil {
 				return err
 			}
+			v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+			if err != nil {
+				return fmt.Errorf("failed to open vault: %w", err)
+			}
 
-			// Save vote data locally
-			if err := gnokey.SaveVoteLocally(requestID, value, salt, hash); err != nil {
-				utils.PrintWarning(fmt.Sprintf("Failed to save vote locally: %v", err))
+			hash, revealDeadline, mnemonic, err := client.CommitVote(v, requestID, value, salt, passphrase)
+			if err != nil {
+				return err
 			}
 
 			utils.PrintSuccess("Vote committed successfully!")
 			utils.PrintInfo(fmt.Sprintf("Request ID: %s", requestID))
 			utils.PrintInfo(fmt.Sprintf("Value: %s", value))
 			utils.PrintInfo(fmt.Sprintf("Hash: %s", hash))
-			utils.PrintInfo("Vote data saved locally for reveal phase")
+			utils.PrintInfo(fmt.Sprintf("Reveal before: %s", utils.FormatTimeRFC3339(revealDeadline)))
+
+			if mnemonic != "" {
+				utils.PrintWarning("New recovery seed generated - write these words down now, this is the only way to re-derive your vote salts if ~/.goo is lost:")
+				fmt.Println(mnemonic)
+			}
 
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&salt, "salt", "", "Salt for vote hash (auto-generated if not provided)")
+	cmd.Flags().BoolVar(&offline, "offline", false, "Build an unsigned tx instead of submitting directly, for signing on an air-gapped machine")
+	cmd.Flags().StringVar(&from, "from", "", "Address that will sign the tx (required with --offline)")
+	cmd.Flags().StringVar(&outPath, "out", "", "Path to write the unsigned tx to (default: <request-id>-vote-commit.unsigned.json)")
+	addGasFlag(cmd)
 
 	return cmd
 }
 
 // NewVoteRevealCmd reveals a committed vote
 func NewVoteRevealCmd() *cobra.Command {
+	var (
+		revealAll bool
+		offline   bool
+		from      string
+		outPath   string
+	)
+
 	cmd := &cobra.Command{
-		Use:   "reveal <request-id>",
+		Use:   "reveal [request-id]",
 		Short: "Reveal a committed vote",
-		Long:  "Reveal your vote during the reveal period using locally stored vote data",
-		Args:  cobra.ExactArgs(1),
-		Example: `  goo vote reveal req-001`,
+		Long:  "Reveal your vote during the reveal period using the vote stored in the encrypted vault. With --offline, the value and salt are still read from the local vault, but the reveal tx is written unsigned to disk instead of submitted, for signing on an air-gapped machine with 'goo tx sign' and 'goo tx broadcast'.",
+		Args:  cobra.MaximumNArgs(1),
+		Example: `  goo vote reveal req-001
+  goo vote reveal --all
+  goo vote reveal req-001 --offline --from g1abcdef...`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			requestID := args[0]
+			if !revealAll && len(args) != 1 {
+				return fmt.Errorf("requires a request-id argument, or --all to reveal every open vote")
+			}
+			if offline && revealAll {
+				return fmt.Errorf("--offline reveals one request at a time; pass a request-id instead of --all")
+			}
 
-			cfg := config.Load()
-			executor := gnokey.NewExecutor(cfg)
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
 
-			// Load vote data from local storage
-			value, salt, err := gnokey.LoadVoteLocally(requestID)
+			gasFlag, _ := cmd.Flags().GetString("gas")
+			gasFee, err := resolveGasFee(gasFlag, cfg)
 			if err != nil {
-				return fmt.Errorf("failed to load vote data: %w", err)
+				return err
 			}
 
-			// Execute transaction
-			funcArgs := []string{requestID, value, salt}
-			if err := executor.CallFunction("RevealVote", funcArgs, ""); err != nil {
+			if offline {
+				if from == "" {
+					return fmt.Errorf("--offline requires --from <address>")
+				}
+				requestID := args[0]
+				if outPath == "" {
+					outPath = fmt.Sprintf("%s-vote-reveal.unsigned.json", requestID)
+				}
+
+				v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+				if err != nil {
+					return fmt.Errorf("failed to open vault: %w", err)
+				}
+				passphrase, err := vault.PromptPassphrase("Vault passphrase: ")
+				if err != nil {
+					return err
+				}
+				rec, err := v.Reveal(requestID, passphrase)
+				if err != nil {
+					return fmt.Errorf("failed to load vote from vault: %w", err)
+				}
+
+				executor := gnokey.NewExecutor(cfg, verbose)
+				if gasFee != "" {
+					executor.GasFee = gasFee
+				}
+				if err := executor.BuildUnsignedTx("RevealVote", []string{requestID, rec.Value, rec.Salt}, "", from, outPath); err != nil {
+					return err
+				}
+
+				utils.PrintSuccess(fmt.Sprintf("Unsigned vote reveal tx written to %s", outPath))
+				utils.PrintInfo(fmt.Sprintf("Sign it on a machine holding the key with: goo tx sign %s --key <name>", outPath))
+				utils.PrintInfo(fmt.Sprintf("Then submit it with: goo tx broadcast %s", outPath))
+
+				return nil
+			}
+
+			waitTarget, waitTimeout, waitOK, err := parseWaitFlags(cmd)
+			if err != nil {
 				return err
 			}
+			if waitOK && revealAll {
+				return fmt.Errorf("--wait reveals one request at a time; pass a request-id instead of --all")
+			}
 
-			utils.PrintSuccess("Vote revealed successfully!")
-			utils.PrintInfo(fmt.Sprintf("Request ID: %s", requestID))
-			utils.PrintInfo(fmt.Sprintf("Value: %s", value))
+			client := gooclient.New(clientContextFrom(cfg, gasFee))
+			client.Verbose(verbose)
+
+			v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+			if err != nil {
+				return fmt.Errorf("failed to open vault: %w", err)
+			}
+
+			requestIDs := args
+			if revealAll {
+				records, err := v.List()
+				if err != nil {
+					return fmt.Errorf("failed to list vault: %w", err)
+				}
+				requestIDs = nil
+				now := time.Now()
+				for _, rec := range records {
+					if rec.IsOpen(now) {
+						requestIDs = append(requestIDs, rec.RequestID)
+					}
+				}
+				if len(requestIDs) == 0 {
+					utils.PrintInfo("No open votes to reveal in vault")
+					return nil
+				}
+			}
+
+			passphrase, err := vault.PromptPassphrase("Vault passphrase: ")
+			if err != nil {
+				return err
+			}
+
+			for _, requestID := range requestIDs {
+				if err := client.RevealVote(v, requestID, passphrase); err != nil {
+					utils.PrintError(fmt.Sprintf("Request %s: %v", requestID, err))
+					continue
+				}
+				utils.PrintSuccess(fmt.Sprintf("Request %s: vote revealed", requestID))
+			}
+
+			if waitOK {
+				executor := gnokey.NewExecutor(cfg, verbose)
+				return WaitForState(cmd.Context(), executor, requestIDs[0], waitTarget, waitTimeout)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&revealAll, "all", false, "Reveal every vote in the vault whose reveal window is still open")
+	cmd.Flags().BoolVar(&offline, "offline", false, "Build an unsigned tx instead of submitting directly, for signing on an air-gapped machine")
+	cmd.Flags().StringVar(&from, "from", "", "Address that will sign the tx (required with --offline)")
+	cmd.Flags().StringVar(&outPath, "out", "", "Path to write the unsigned tx to (default: <request-id>-vote-reveal.unsigned.json)")
+	addGasFlag(cmd)
+	addWaitFlags(cmd)
+
+	return cmd
+}
+
+// NewVoteListCmd lists votes tracked in the local vault
+func NewVoteListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List votes tracked in the local vault",
+		Long:  "Show every committed vote in the vault along with its reveal deadline, without needing the vault passphrase",
+		Example: `  goo vote list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyOverride, _ := cmd.Flags().GetString("key")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+			if err != nil {
+				return fmt.Errorf("failed to open vault: %w", err)
+			}
+
+			records, err := v.List()
+			if err != nil {
+				return fmt.Errorf("failed to list vault: %w", err)
+			}
+
+			if len(records) == 0 {
+				utils.PrintInfo("No votes tracked in vault")
+				return nil
+			}
+
+			now := time.Now()
+			utils.PrintSection("Vault Votes")
+			for _, rec := range records {
+				fmt.Println()
+				utils.PrintKeyValue("  Request ID", rec.RequestID)
+				utils.PrintKeyValue("  Committed At", utils.FormatTimeRFC3339(rec.CommittedAt))
+				utils.PrintKeyValue("  Reveal Deadline", utils.FormatTimeRFC3339(rec.RevealDeadline))
+				if rec.IsOpen(now) {
+					utils.PrintKeyValue("  Status", "Open (not yet revealed)")
+				} else {
+					utils.PrintKeyValue("  Status", "Closed")
+				}
+			}
+			fmt.Println()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewVoteWatchCmd polls the vault and reveals votes as their window opens
+func NewVoteWatchCmd() *cobra.Command {
+	var interval int
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Watch the vault and reveal votes automatically",
+		Long:  "Poll the local vault and submit the reveal transaction for each vote as soon as it is still within its reveal window. Runs until interrupted.",
+		Example: `  goo vote watch
+  goo vote watch --interval 30`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyOverride, _ := cmd.Flags().GetString("key")
+			verbose, _ := cmd.Flags().GetBool("verbose")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+			client := gooclient.New(clientContextFrom(cfg, ""))
+			client.Verbose(verbose)
+
+			v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+			if err != nil {
+				return fmt.Errorf("failed to open vault: %w", err)
+			}
+
+			passphrase, err := vault.PromptPassphrase("Vault passphrase (used for every vote revealed this session): ")
+			if err != nil {
+				return err
+			}
+
+			revealed := make(map[string]bool)
+			utils.PrintInfo(fmt.Sprintf("Watching vault every %ds for votes to reveal (Ctrl+C to stop)...", interval))
+
+			for {
+				records, err := v.List()
+				if err != nil {
+					return fmt.Errorf("failed to list vault: %w", err)
+				}
+
+				now := time.Now()
+				for _, rec := range records {
+					if revealed[rec.RequestID] || !rec.IsOpen(now) {
+						continue
+					}
+					if err := client.RevealVote(v, rec.RequestID, passphrase); err != nil {
+						utils.PrintError(fmt.Sprintf("Request %s: %v", rec.RequestID, err))
+						continue
+					}
+					utils.PrintSuccess(fmt.Sprintf("Request %s: vote revealed", rec.RequestID))
+					revealed[rec.RequestID] = true
+				}
+
+				time.Sleep(time.Duration(interval) * time.Second)
+			}
+		},
+	}
+
+	cmd.Flags().IntVar(&interval, "interval", 60, "Polling interval in seconds")
+
+	return cmd
+}
+
+// NewVoteRecoverCmd walks the vault's index to help recover or migrate
+// votes: on its own it just reports what the index knows about, and
+// --export/--import move that same (still-encrypted) data to and from a
+// bundle file for transplanting onto another machine.
+func NewVoteRecoverCmd() *cobra.Command {
+	var (
+		exportPath string
+		importPath string
+		prune      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "recover",
+		Short: "Recover or migrate votes tracked in the local vault",
+		Long:  "Walks the vault's index to report every vote it knows about. --export writes the vault's still-encrypted records to a bundle file for copying to another machine; --import merges a bundle produced this way back into the vault. --prune removes records whose reveal window has already closed.",
+		Example: `  goo vote recover
+  goo vote recover --export votes.bundle.json
+  goo vote recover --import votes.bundle.json
+  goo vote recover --prune`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyOverride, _ := cmd.Flags().GetString("key")
+			profileOverride, _ := cmd.Flags().GetString("profile")
+			cfg := config.LoadWithOverrides(keyOverride, profileOverride)
+
+			v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+			if err != nil {
+				return fmt.Errorf("failed to open vault: %w", err)
+			}
+
+			if importPath != "" {
+				count, err := v.Import(importPath)
+				if err != nil {
+					return fmt.Errorf("failed to import vote bundle: %w", err)
+				}
+				utils.PrintSuccess(fmt.Sprintf("Imported %d vote(s) from %s", count, importPath))
+				return nil
+			}
+
+			if exportPath != "" {
+				if err := v.Export(exportPath); err != nil {
+					return fmt.Errorf("failed to export vote bundle: %w", err)
+				}
+				utils.PrintSuccess(fmt.Sprintf("Exported vault to %s (still encrypted, same passphrase works on import)", exportPath))
+				return nil
+			}
+
+			if prune {
+				removed, err := v.Prune(time.Now())
+				if err != nil {
+					return fmt.Errorf("failed to prune vault: %w", err)
+				}
+				if len(removed) == 0 {
+					utils.PrintInfo("No expired votes to prune")
+					return nil
+				}
+				utils.PrintSuccess(fmt.Sprintf("Pruned %d expired vote(s): %s", len(removed), strings.Join(removed, ", ")))
+				return nil
+			}
+
+			records, err := v.List()
+			if err != nil {
+				return fmt.Errorf("failed to read vault index: %w", err)
+			}
+			if len(records) == 0 {
+				utils.PrintInfo(fmt.Sprintf("No votes tracked for %s on %s", cfg.KeyName, cfg.ChainID))
+				return nil
+			}
+
+			now := time.Now()
+			utils.PrintSection(fmt.Sprintf("Vault Index (%s / %s)", cfg.ChainID, cfg.KeyName))
+			for _, rec := range records {
+				fmt.Println()
+				utils.PrintKeyValue("  Request ID", rec.RequestID)
+				utils.PrintKeyValue("  Committed At", utils.FormatTimeRFC3339(rec.CommittedAt))
+				utils.PrintKeyValue("  Reveal Deadline", utils.FormatTimeRFC3339(rec.RevealDeadline))
+				if rec.IsOpen(now) {
+					utils.PrintKeyValue("  Status", "Recoverable (reveal window open)")
+				} else {
+					utils.PrintKeyValue("  Status", "Expired (past reveal window)")
+				}
+			}
+			fmt.Println()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&exportPath, "export", "", "Write the vault's encrypted records to this bundle file")
+	cmd.Flags().StringVar(&importPath, "import", "", "Merge a bundle file produced by --export back into the vault")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Remove vault records whose reveal window has already closed")
+
+	return cmd
+}
+
+// NewVoteImportMnemonicCmd restores ~/.goo/seed from a previously-shown
+// recovery mnemonic, for a new machine or after ~/.goo was lost.
+func NewVoteImportMnemonicCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-mnemonic",
+		Short: "Restore the deterministic vote salt seed from its recovery mnemonic",
+		Long:  "Re-derives ~/.goo/seed from a previously-shown 12/24-word mnemonic, encrypting it at rest under a passphrase. Use this on a new machine, or after ~/.goo was lost, to regain the ability to derive the same vote salts 'goo vote commit' used before.",
+		Example: `  goo vote import-mnemonic`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mnemonic, err := vault.PromptPassphrase("Recovery mnemonic (12/24 words): ")
+			if err != nil {
+				return err
+			}
+			passphrase, err := vault.PromptPassphrase("Passphrase to encrypt the seed with (used when deriving vote salts): ")
+			if err != nil {
+				return err
+			}
+
+			store, err := vault.OpenSeedStore()
+			if err != nil {
+				return err
+			}
+			if err := store.ImportMnemonic(mnemonic, passphrase); err != nil {
+				return fmt.Errorf("failed to import mnemonic: %w", err)
+			}
+
+			utils.PrintSuccess("Recovery seed restored to ~/.goo/seed")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewVoteExportMnemonicCmd shows the recovery mnemonic backing the local
+// deterministic vote salt seed again, for writing down or copying to
+// another machine.
+func NewVoteExportMnemonicCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-mnemonic",
+		Short: "Show the recovery mnemonic for the deterministic vote salt seed",
+		Long:  "Decrypts ~/.goo/seed and re-encodes it as its BIP39 mnemonic, for writing down as a backup or carrying over to another machine.",
+		Example: `  goo vote export-mnemonic`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := vault.OpenSeedStore()
+			if err != nil {
+				return err
+			}
+			if !store.Exists() {
+				return fmt.Errorf("no recovery seed found at ~/.goo/seed yet - it's created the first time 'goo vote commit' derives a salt")
+			}
+
+			passphrase, err := vault.PromptPassphrase("Passphrase the seed was encrypted with: ")
+			if err != nil {
+				return err
+			}
+
+			mnemonic, err := store.ExportMnemonic(passphrase)
+			if err != nil {
+				return fmt.Errorf("failed to export mnemonic: %w", err)
+			}
 
+			utils.PrintWarning("Anyone with this mnemonic and your passphrase can reproduce every vote salt it ever derived:")
+			fmt.Println(mnemonic)
 			return nil
 		},
 	}
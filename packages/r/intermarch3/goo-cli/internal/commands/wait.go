@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+	"goo-cli/pkg/types"
+)
+
+// waitPollInterval is how often WaitForState re-queries GetRequest.
+const waitPollInterval = 5 * time.Second
+
+// addWaitFlags registers --wait and --wait-timeout on a command whose RunE
+// submits a transaction that advances a request through its state machine.
+// --wait takes the target types.RequestState name to block for (e.g.
+// "Proposed", "Disputed", "Resolved"); RunE is responsible for calling
+// WaitForState with it once the transaction succeeds.
+func addWaitFlags(cmd *cobra.Command) {
+	cmd.Flags().String("wait", "", "Block until the request reaches this state: Requested, Proposed, Disputed, Resolved")
+	cmd.Flags().Duration("wait-timeout", 10*time.Minute, "Give up waiting after this long")
+}
+
+// parseWaitFlags reads --wait/--wait-timeout, returning ok=false when --wait
+// wasn't set (nothing to wait for).
+func parseWaitFlags(cmd *cobra.Command) (target types.RequestState, timeout time.Duration, ok bool, err error) {
+	waitFor, _ := cmd.Flags().GetString("wait")
+	if waitFor == "" {
+		return 0, 0, false, nil
+	}
+	timeout, _ = cmd.Flags().GetDuration("wait-timeout")
+
+	switch waitFor {
+	case "Requested":
+		target = types.StateRequested
+	case "Proposed":
+		target = types.StateProposed
+	case "Disputed":
+		target = types.StateDisputed
+	case "Resolved":
+		target = types.StateResolved
+	default:
+		return 0, 0, false, fmt.Errorf("invalid --wait state '%s'. Valid states are: Requested, Proposed, Disputed, Resolved", waitFor)
+	}
+	return target, timeout, true, nil
+}
+
+// WaitForState polls GetRequest every waitPollInterval until requestID
+// reaches target, ctx is canceled, or timeout elapses, printing a spinner
+// with the current state as it goes. It errors out rather than waiting
+// forever once the request's own Deadline has passed without a proposal -
+// the one expiry types.Request actually tracks ahead of time; ResolutionTime
+// is only populated once a request resolves, so reaching StateResolved is
+// success, not an expiry to guard against. It also errors out immediately if
+// the request's state has already advanced past target (e.g. --wait Disputed
+// on a request that resolved without ever being disputed): RequestState is a
+// monotonically increasing iota, so once req.State > target, target can
+// never be reached and there's nothing left to poll for.
+func WaitForState(ctx context.Context, executor *gnokey.TxExecutor, requestID string, target types.RequestState, timeout time.Duration) error {
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	spinner := []string{"|", "/", "-", "\\"}
+	frame := 0
+
+	for {
+		result, err := executor.QueryFunction("GetRequest", []string{requestID})
+		if err != nil {
+			return fmt.Errorf("failed to query request while waiting: %w", err)
+		}
+		req, err := gnoabi.DecodeRequest(result)
+		if err != nil {
+			return fmt.Errorf("failed to decode request while waiting: %w", err)
+		}
+
+		fmt.Printf("\r%s waiting for %s (currently %s)...   ", spinner[frame%len(spinner)], target, req.State)
+		frame++
+
+		if req.State == target {
+			fmt.Println()
+			utils.PrintSuccess(fmt.Sprintf("Request %s reached state %s", requestID, target))
+			return nil
+		}
+
+		if req.State == types.StateRequested && time.Now().After(req.Deadline) {
+			fmt.Println()
+			return fmt.Errorf("request %s passed its deadline (%s) without a proposal", requestID, utils.FormatTimeRFC3339(req.Deadline))
+		}
+
+		if req.State > target {
+			fmt.Println()
+			return fmt.Errorf("request %s already passed state %s (currently %s)", requestID, target, req.State)
+		}
+
+		select {
+		case <-deadlineCtx.Done():
+			fmt.Println()
+			return fmt.Errorf("timed out waiting for request %s to reach state %s (currently %s)", requestID, target, req.State)
+		case <-time.After(waitPollInterval):
+		}
+	}
+}
@@ -7,29 +7,215 @@ import (
 
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v3"
+
+	"goo-cli/pkg/types"
 )
 
+// Profile holds the network-specific settings for a single gno.land realm
+// deployment of the oracle: which key signs, which realm, and how to reach
+// it. Config.Profiles keys a named set of these (e.g. "dev", "mainnet") so a
+// single config file can drive several deployments.
+type Profile struct {
+	KeyName   string `yaml:"keyname" mapstructure:"keyname"`
+	RealmPath string `yaml:"realm_path" mapstructure:"realm_path"`
+	ChainID   string `yaml:"chain_id" mapstructure:"chain_id"`
+	Remote    string `yaml:"remote" mapstructure:"remote"`
+	GasFee    string `yaml:"gas_fee" mapstructure:"gas_fee"`
+	GasWanted int64  `yaml:"gas_wanted" mapstructure:"gas_wanted"`
+}
+
 // Config holds the CLI configuration
 type Config struct {
-	KeyName      string `yaml:"keyname" mapstructure:"keyname"`
-	RealmPath    string `yaml:"realm_path" mapstructure:"realm_path"`
-	ChainID      string `yaml:"chain_id" mapstructure:"chain_id"`
-	Remote       string `yaml:"remote" mapstructure:"remote"`
-	GasFee       string `yaml:"gas_fee" mapstructure:"gas_fee"`
-	GasWanted    int64  `yaml:"gas_wanted" mapstructure:"gas_wanted"`
+	// CurrentProfile names the Profiles entry used when --profile is not
+	// passed.
+	CurrentProfile string `yaml:"current_profile" mapstructure:"current_profile"`
+	// Profiles maps a profile name to its network settings, e.g. "dev",
+	// "portal-loop", "mainnet".
+	Profiles map[string]Profile `yaml:"profiles" mapstructure:"profiles"`
+
+	// KeyName, RealmPath, ChainID, Remote, GasFee, and GasWanted are the
+	// resolved fields of the active profile (CurrentProfile, or --profile
+	// when set). They are not serialized; edit Profiles instead.
+	KeyName   string `yaml:"-" mapstructure:"-"`
+	RealmPath string `yaml:"-" mapstructure:"-"`
+	ChainID   string `yaml:"-" mapstructure:"-"`
+	Remote    string `yaml:"-" mapstructure:"-"`
+	GasFee    string `yaml:"-" mapstructure:"-"`
+	GasWanted int64  `yaml:"-" mapstructure:"-"`
+
 	GoogleAPIKey string `yaml:"google_api_key" mapstructure:"google_api_key"`
+
+	// Provider selects the default AI research backend for `propose value --search`:
+	// "gemini", "openai", "anthropic", or "ollama".
+	Provider        string `yaml:"provider" mapstructure:"provider"`
+	OpenAIAPIKey    string `yaml:"openai_api_key" mapstructure:"openai_api_key"`
+	AnthropicAPIKey string `yaml:"anthropic_api_key" mapstructure:"anthropic_api_key"`
+	OllamaEndpoint  string `yaml:"ollama_endpoint" mapstructure:"ollama_endpoint"`
+	OllamaModel     string `yaml:"ollama_model" mapstructure:"ollama_model"`
+
+	// ConsensusProviders lists the providers `--consensus N` fans out to, in
+	// priority order; the first N with a configured key/endpoint are used.
+	ConsensusProviders []string `yaml:"consensus_providers" mapstructure:"consensus_providers"`
+	// ConsensusThreshold is the maximum allowed disagreement (0-1) before
+	// the CLI refuses to auto-propose; see search_agent.Ensemble.
+	ConsensusThreshold float64 `yaml:"consensus_threshold" mapstructure:"consensus_threshold"`
+
+	// ServeListenAddr is the address `goo serve` binds its GraphQL/HTTP
+	// server to.
+	ServeListenAddr string `yaml:"serve_listen_addr" mapstructure:"serve_listen_addr"`
+	// ServeCacheTTLSeconds caches gnokey query results for this long before
+	// re-querying the RPC endpoint.
+	ServeCacheTTLSeconds int64 `yaml:"serve_cache_ttl_seconds" mapstructure:"serve_cache_ttl_seconds"`
+	// ServePollIntervalSeconds is how often subscription resolvers re-poll
+	// GetRequest/GetDispute for changes.
+	ServePollIntervalSeconds int64 `yaml:"serve_poll_interval_seconds" mapstructure:"serve_poll_interval_seconds"`
+	// ServePlayground enables the GraphQL Playground UI at /playground.
+	ServePlayground bool `yaml:"serve_playground" mapstructure:"serve_playground"`
+
+	// MultisigKey is the local gnokey keyname of the m-of-n multisig
+	// account that governs admin operations, if any. When set, admin
+	// subcommands refuse to sign and broadcast directly with any key; only
+	// --generate-only runs are let through.
+	MultisigKey string `yaml:"multisig_key" mapstructure:"multisig_key"`
+	// Threshold is the number of signatures (m) required by MultisigKey.
+	Threshold int `yaml:"threshold" mapstructure:"threshold"`
+
+	// MetricsListenAddr is the address `goo serve --metrics` binds its
+	// Prometheus /metrics endpoint to.
+	MetricsListenAddr string `yaml:"metrics_listen_addr" mapstructure:"metrics_listen_addr"`
+	// MetricsScrapeIntervalSeconds is how often contract-derived gauges
+	// (bond, reward, resolution duration, open requests, active disputes)
+	// are re-scraped from the chain.
+	MetricsScrapeIntervalSeconds int64 `yaml:"metrics_scrape_interval_seconds" mapstructure:"metrics_scrape_interval_seconds"`
+
+	// DiscordBotToken authenticates `goo bot discord` against the Discord
+	// gateway.
+	DiscordBotToken string `yaml:"discord_bot_token" mapstructure:"discord_bot_token"`
+	// DiscordNotifyChannels maps an event kind ("request_created",
+	// "proposal_submitted", "dispute_opened", "reveal_ending") to the
+	// Discord channel ID it should be posted to. An event kind with no
+	// entry is not posted.
+	DiscordNotifyChannels map[string]string `yaml:"discord_notify_channels" mapstructure:"discord_notify_channels"`
+	// DiscordUserKeys maps a Discord user ID to the local gnokey keyname
+	// that signs transactions the bot submits on that user's behalf.
+	// Users without an entry here can't drive transactions through the bot.
+	DiscordUserKeys map[string]string `yaml:"discord_user_keys" mapstructure:"discord_user_keys"`
+	// DiscordPollIntervalSeconds is how often the bot re-polls contract
+	// state to detect new requests, proposals, disputes, and closing
+	// reveal windows.
+	DiscordPollIntervalSeconds int64 `yaml:"discord_poll_interval_seconds" mapstructure:"discord_poll_interval_seconds"`
+
+	// GasSampleBlocks is how many recent blocks `--gas auto` averages gas
+	// utilization over.
+	GasSampleBlocks int64 `yaml:"gas_sample_blocks" mapstructure:"gas_sample_blocks"`
+	// GasCeilingUgnot is the highest gas fee `--gas auto` may settle on
+	// before warning the user; 0 disables the check.
+	GasCeilingUgnot int64 `yaml:"gas_ceiling_ugnot" mapstructure:"gas_ceiling_ugnot"`
+
+	// Language selects the locale utils.ParseContractError renders
+	// friendly messages in (e.g. "en", "fr"). The $LANG environment
+	// variable takes priority when set; this field is the fallback.
+	Language string `yaml:"language" mapstructure:"language"`
+
+	// oracleParams memoizes 'query params --json' for this process's
+	// lifetime (unexported, so it's never read from or written to the
+	// config file): the realm has no aggregate GetParams() in this tree,
+	// so every fetch is still six round trips, and long-lived processes
+	// like goo serve shouldn't repeat them on every call.
+	oracleParams *types.OracleParams
+}
+
+// CachedParams returns the oracle parameters cached by a prior
+// CacheParams call this process, or ok=false if none have been cached yet.
+func (c *Config) CachedParams() (params *types.OracleParams, ok bool) {
+	return c.oracleParams, c.oracleParams != nil
+}
+
+// CacheParams stores params for the remainder of this process's lifetime.
+func (c *Config) CacheParams(params *types.OracleParams) {
+	c.oracleParams = params
 }
 
 // DefaultConfig returns a config with default values
 func DefaultConfig() *Config {
-	return &Config{
-		KeyName:    "mykey",
-		RealmPath:  "gno.land/r/intermarch3/goo",
-		ChainID:    "dev",
-		Remote:     "tcp://127.0.0.1:26657",
-		GasFee:     "1000000ugnot",
-		GasWanted:  20000000,
+	devProfile := Profile{
+		KeyName:   "mykey",
+		RealmPath: "gno.land/r/intermarch3/goo",
+		ChainID:   "dev",
+		Remote:    "tcp://127.0.0.1:26657",
+		GasFee:    "1000000ugnot",
+		GasWanted: 20000000,
 	}
+
+	cfg := &Config{
+		CurrentProfile: "dev",
+		Profiles:       map[string]Profile{"dev": devProfile},
+
+		Provider:           "gemini",
+		ConsensusProviders: []string{"gemini", "openai", "anthropic"},
+		ConsensusThreshold: 0.2,
+
+		ServeListenAddr:          ":8085",
+		ServeCacheTTLSeconds:     10,
+		ServePollIntervalSeconds: 5,
+		ServePlayground:          true,
+
+		MetricsListenAddr:            ":9105",
+		MetricsScrapeIntervalSeconds: 30,
+
+		DiscordPollIntervalSeconds: 30,
+
+		GasSampleBlocks: 20,
+
+		Language: "en",
+	}
+	applyProfile(cfg, devProfile)
+	return cfg
+}
+
+// applyProfile flattens p's fields onto cfg's resolved active-profile fields.
+func applyProfile(cfg *Config, p Profile) {
+	cfg.KeyName = p.KeyName
+	cfg.RealmPath = p.RealmPath
+	cfg.ChainID = p.ChainID
+	cfg.Remote = p.Remote
+	cfg.GasFee = p.GasFee
+	cfg.GasWanted = p.GasWanted
+}
+
+// SyncActiveProfile writes cfg's resolved active-profile fields back into
+// cfg.Profiles[cfg.CurrentProfile], for callers (like `goo config init`)
+// that mutate cfg.KeyName/ChainID/etc. directly before saving.
+func (cfg *Config) SyncActiveProfile() {
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]Profile{}
+	}
+	cfg.Profiles[cfg.CurrentProfile] = Profile{
+		KeyName:   cfg.KeyName,
+		RealmPath: cfg.RealmPath,
+		ChainID:   cfg.ChainID,
+		Remote:    cfg.Remote,
+		GasFee:    cfg.GasFee,
+		GasWanted: cfg.GasWanted,
+	}
+}
+
+// resolveProfile picks profileOverride (or cfg.CurrentProfile when empty)
+// out of cfg.Profiles and flattens it onto cfg's resolved fields. Falls back
+// to DefaultConfig's "dev" profile if the name isn't found.
+func resolveProfile(cfg *Config, profileOverride string) {
+	name := cfg.CurrentProfile
+	if profileOverride != "" {
+		name = profileOverride
+	}
+
+	if p, ok := cfg.Profiles[name]; ok {
+		applyProfile(cfg, p)
+		return
+	}
+
+	fmt.Printf("Warning: profile %q not found, using defaults\n", name)
+	applyProfile(cfg, DefaultConfig().Profiles["dev"])
 }
 
 // GetConfigPath returns the path to the config file
@@ -41,12 +227,21 @@ func GetConfigPath() (string, error) {
 	return filepath.Join(homeDir, ".goo", "config.yaml"), nil
 }
 
-// Load reads the configuration from file or returns defaults
+// Load reads the configuration from file (migrating a legacy flat config to
+// a "default" profile on first read) and resolves CurrentProfile, or
+// returns defaults.
 func Load() *Config {
+	return LoadWithOverrides("", "")
+}
+
+// LoadWithOverrides loads config, resolves profileOverride (or
+// CurrentProfile when empty) into the active profile fields, then applies
+// keyOverride on top.
+func LoadWithOverrides(keyOverride, profileOverride string) *Config {
 	configPath, err := GetConfigPath()
 	if err != nil {
 		fmt.Printf("Warning: %v, using defaults\n", err)
-		return DefaultConfig()
+		return applyOverrides(DefaultConfig(), keyOverride, profileOverride)
 	}
 
 	viper.SetConfigFile(configPath)
@@ -55,30 +250,59 @@ func Load() *Config {
 	if err := viper.ReadInConfig(); err != nil {
 		// If config doesn't exist, return defaults
 		if os.IsNotExist(err) {
-			return DefaultConfig()
+			return applyOverrides(DefaultConfig(), keyOverride, profileOverride)
 		}
 		fmt.Printf("Warning: failed to read config: %v, using defaults\n", err)
-		return DefaultConfig()
+		return applyOverrides(DefaultConfig(), keyOverride, profileOverride)
 	}
 
 	var cfg Config
 	if err := viper.Unmarshal(&cfg); err != nil {
 		fmt.Printf("Warning: failed to parse config: %v, using defaults\n", err)
-		return DefaultConfig()
+		return applyOverrides(DefaultConfig(), keyOverride, profileOverride)
+	}
+
+	if !viper.IsSet("profiles") {
+		migrateLegacyConfig(&cfg)
 	}
 
+	resolveProfile(&cfg, profileOverride)
+	if keyOverride != "" {
+		cfg.KeyName = keyOverride
+	}
 	return &cfg
 }
 
-// LoadWithKeyOverride loads config and overrides the key name if provided
-func LoadWithKeyOverride(keyOverride string) *Config {
-	cfg := Load()
+func applyOverrides(cfg *Config, keyOverride, profileOverride string) *Config {
+	resolveProfile(cfg, profileOverride)
 	if keyOverride != "" {
 		cfg.KeyName = keyOverride
 	}
 	return cfg
 }
 
+// migrateLegacyConfig wraps a pre-profile flat config (keyname/realm_path/
+// chain_id/remote/gas_fee/gas_wanted at the top level) into a "default"
+// profile and rewrites the config file so future loads see the new shape.
+func migrateLegacyConfig(cfg *Config) {
+	legacy := Profile{
+		KeyName:   viper.GetString("keyname"),
+		RealmPath: viper.GetString("realm_path"),
+		ChainID:   viper.GetString("chain_id"),
+		Remote:    viper.GetString("remote"),
+		GasFee:    viper.GetString("gas_fee"),
+		GasWanted: viper.GetInt64("gas_wanted"),
+	}
+
+	cfg.CurrentProfile = "default"
+	cfg.Profiles = map[string]Profile{"default": legacy}
+
+	fmt.Println("Migrating legacy config.yaml to named profiles (wrapped into \"default\")...")
+	if err := Save(cfg); err != nil {
+		fmt.Printf("Warning: failed to rewrite migrated config: %v\n", err)
+	}
+}
+
 // Save writes the configuration to file
 func Save(cfg *Config) error {
 	configPath, err := GetConfigPath()
@@ -126,6 +350,7 @@ func InitConfig() error {
 
 	fmt.Printf("✓ Config file created at %s\n", configPath)
 	fmt.Println("\nDefault configuration:")
+	fmt.Printf("  Profile:       %s\n", cfg.CurrentProfile)
 	fmt.Printf("  Key Name:      %s\n", cfg.KeyName)
 	fmt.Printf("  Realm Path:    %s\n", cfg.RealmPath)
 	fmt.Printf("  Chain ID:      %s\n", cfg.ChainID)
@@ -137,7 +362,7 @@ func InitConfig() error {
 	} else {
 		fmt.Printf("  Google API Key: (not configured)\n")
 	}
-	fmt.Println("\nEdit this file to customize your settings.")
+	fmt.Println("\nEdit this file to customize your settings, or add more profiles with 'goo config add'.")
 
 	return nil
 }
@@ -0,0 +1,84 @@
+package discordbot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+	"goo-cli/internal/vault"
+)
+
+// Bot wires a discordgo session to the oracle: it registers slash commands
+// that drive propose/dispute/vote-commit/vote-reveal transactions, and runs
+// a poller that announces state transitions to configured channels.
+type Bot struct {
+	session  *discordgo.Session
+	executor *gnokey.TxExecutor
+	cfg      *config.Config
+	vault    *vault.Vault
+	poller   *poller
+}
+
+// New creates a Bot. It does not open the Discord session yet; call Run.
+func New(cfg *config.Config, executor *gnokey.TxExecutor) (*Bot, error) {
+	if cfg.DiscordBotToken == "" {
+		return nil, fmt.Errorf("discord_bot_token is not set in config")
+	}
+
+	session, err := discordgo.New("Bot " + cfg.DiscordBotToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discord session: %w", err)
+	}
+
+	v, err := vault.Open(cfg.ChainID, cfg.KeyName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open vault: %w", err)
+	}
+
+	interval := time.Duration(cfg.DiscordPollIntervalSeconds) * time.Second
+
+	b := &Bot{
+		session:  session,
+		executor: executor,
+		cfg:      cfg,
+		vault:    v,
+	}
+	b.poller = newPoller(session, executor, cfg.DiscordNotifyChannels, interval)
+	session.AddHandler(b.handleInteraction)
+
+	return b, nil
+}
+
+// Run opens the Discord session, registers the bot's slash commands, and
+// blocks the poller until ctx is cancelled.
+func (b *Bot) Run(ctx context.Context) error {
+	if err := b.session.Open(); err != nil {
+		return fmt.Errorf("failed to open discord session: %w", err)
+	}
+	defer b.session.Close()
+
+	registered, err := registerCommands(b.session)
+	if err != nil {
+		return fmt.Errorf("failed to register slash commands: %w", err)
+	}
+	defer unregisterCommands(b.session, registered)
+
+	utils.PrintInfo("Discord bot connected and slash commands registered")
+
+	b.poller.run(ctx)
+	return nil
+}
+
+// keyForUser looks up the gnokey keyname a Discord user signs with.
+func (b *Bot) keyForUser(discordUserID string) (string, error) {
+	keyName, ok := b.cfg.DiscordUserKeys[discordUserID]
+	if !ok || keyName == "" {
+		return "", fmt.Errorf("your Discord account is not linked to a gnokey keyname (ask an admin to add it to discord_user_keys)")
+	}
+	return keyName, nil
+}
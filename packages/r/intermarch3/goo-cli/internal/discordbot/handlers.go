@@ -0,0 +1,284 @@
+package discordbot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"goo-cli/internal/actions"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/vault"
+)
+
+var slashCommands = []*discordgo.ApplicationCommand{
+	{
+		Name:        "goo-propose",
+		Description: "Propose a value for a data request",
+		Options: []*discordgo.ApplicationCommandOption{
+			{Type: discordgo.ApplicationCommandOptionString, Name: "request-id", Description: "Request ID", Required: true},
+			{Type: discordgo.ApplicationCommandOptionString, Name: "value", Description: "Proposed value", Required: true},
+		},
+	},
+	{
+		Name:        "goo-dispute",
+		Description: "Dispute a request's proposed value",
+		Options: []*discordgo.ApplicationCommandOption{
+			{Type: discordgo.ApplicationCommandOptionString, Name: "request-id", Description: "Request ID", Required: true},
+		},
+	},
+	{
+		Name:        "goo-vote-commit",
+		Description: "Commit a vote on a dispute",
+		Options: []*discordgo.ApplicationCommandOption{
+			{Type: discordgo.ApplicationCommandOptionString, Name: "request-id", Description: "Request ID", Required: true},
+			{Type: discordgo.ApplicationCommandOptionString, Name: "value", Description: "Vote value", Required: true},
+		},
+	},
+	{
+		Name:        "goo-vote-reveal",
+		Description: "Reveal a committed vote",
+		Options: []*discordgo.ApplicationCommandOption{
+			{Type: discordgo.ApplicationCommandOptionString, Name: "request-id", Description: "Request ID", Required: true},
+		},
+	},
+}
+
+// Modal custom IDs carry the command's other arguments (request-id, value)
+// since goo-vote-commit/goo-vote-reveal no longer take the passphrase as a
+// visible slash-command option - Discord echoes a slash command and its
+// option values into the channel history for everyone to see, regardless of
+// how the bot's own reply is flagged, which would leak the vault passphrase
+// to the whole channel. A modal's submitted field values are only ever
+// delivered to the bot, never rendered in channel history.
+const (
+	modalVoteCommitPrefix = "goo-vote-commit-modal:"
+	modalVoteRevealPrefix = "goo-vote-reveal-modal:"
+	passphraseFieldID     = "passphrase"
+)
+
+// registerCommands creates all of slashCommands as global application
+// commands and returns the created commands (needed to unregister later).
+func registerCommands(session *discordgo.Session) ([]*discordgo.ApplicationCommand, error) {
+	created := make([]*discordgo.ApplicationCommand, 0, len(slashCommands))
+	for _, cmd := range slashCommands {
+		c, err := session.ApplicationCommandCreate(session.State.User.ID, "", cmd)
+		if err != nil {
+			return created, fmt.Errorf("failed to register /%s: %w", cmd.Name, err)
+		}
+		created = append(created, c)
+	}
+	return created, nil
+}
+
+// unregisterCommands removes global application commands on shutdown so
+// repeated `goo bot discord` runs don't accumulate stale duplicates.
+func unregisterCommands(session *discordgo.Session, created []*discordgo.ApplicationCommand) {
+	for _, cmd := range created {
+		session.ApplicationCommandDelete(session.State.User.ID, "", cmd.ID)
+	}
+}
+
+// handleInteraction dispatches a slash command or modal submission to its
+// handler, using the invoking Discord user's linked gnokey keyname to sign.
+func (b *Bot) handleInteraction(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	switch interaction.Type {
+	case discordgo.InteractionApplicationCommand:
+		b.handleCommand(session, interaction)
+	case discordgo.InteractionModalSubmit:
+		b.handleModalSubmit(session, interaction)
+	}
+}
+
+func (b *Bot) handleCommand(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	data := interaction.ApplicationCommandData()
+	opts := optionMap(data.Options)
+
+	switch data.Name {
+	case "goo-propose":
+		userID := interactionUserID(interaction)
+		keyName, err := b.keyForUser(userID)
+		if err != nil {
+			b.reply(session, interaction, err.Error())
+			return
+		}
+		executor := *b.executor
+		executor.KeyName = keyName
+		b.handlePropose(session, interaction, &executor, opts)
+	case "goo-dispute":
+		userID := interactionUserID(interaction)
+		keyName, err := b.keyForUser(userID)
+		if err != nil {
+			b.reply(session, interaction, err.Error())
+			return
+		}
+		executor := *b.executor
+		executor.KeyName = keyName
+		b.handleDispute(session, interaction, &executor, opts)
+	case "goo-vote-commit":
+		requestID := opts["request-id"].StringValue()
+		value := opts["value"].StringValue()
+		b.promptPassphrase(session, interaction, modalVoteCommitPrefix+requestID+"|"+value, "Commit vote")
+	case "goo-vote-reveal":
+		requestID := opts["request-id"].StringValue()
+		b.promptPassphrase(session, interaction, modalVoteRevealPrefix+requestID, "Reveal vote")
+	}
+}
+
+// promptPassphrase opens a modal asking for the vault passphrase, carrying
+// the rest of the command's arguments in customID so handleModalSubmit can
+// recover them once the user submits.
+func (b *Bot) promptPassphrase(session *discordgo.Session, interaction *discordgo.InteractionCreate, customID, title string) {
+	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: customID,
+			Title:    title,
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    passphraseFieldID,
+							Label:       "Vault passphrase",
+							Style:       discordgo.TextInputShort,
+							Required:    true,
+							Placeholder: "Never shown in channel history",
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		fmt.Printf("Warning: failed to open passphrase modal: %v\n", err)
+	}
+}
+
+func (b *Bot) handleModalSubmit(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	data := interaction.ModalSubmitData()
+	passphrase := modalFieldValue(data, passphraseFieldID)
+
+	userID := interactionUserID(interaction)
+	keyName, err := b.keyForUser(userID)
+	if err != nil {
+		b.reply(session, interaction, err.Error())
+		return
+	}
+	executor := *b.executor
+	executor.KeyName = keyName
+
+	switch {
+	case strings.HasPrefix(data.CustomID, modalVoteCommitPrefix):
+		rest := strings.TrimPrefix(data.CustomID, modalVoteCommitPrefix)
+		requestID, value, ok := strings.Cut(rest, "|")
+		if !ok {
+			b.reply(session, interaction, "Vote commit failed: malformed modal state")
+			return
+		}
+		b.handleVoteCommit(session, interaction, &executor, requestID, value, passphrase)
+	case strings.HasPrefix(data.CustomID, modalVoteRevealPrefix):
+		requestID := strings.TrimPrefix(data.CustomID, modalVoteRevealPrefix)
+		b.handleVoteReveal(session, interaction, &executor, requestID, passphrase)
+	}
+}
+
+// modalFieldValue finds fieldID's submitted value among a modal's action
+// rows - discordgo nests each input one level deep under an ActionsRow.
+func modalFieldValue(data discordgo.ModalSubmitInteractionData, fieldID string) string {
+	for _, row := range data.Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, comp := range actionsRow.Components {
+			if input, ok := comp.(*discordgo.TextInput); ok && input.CustomID == fieldID {
+				return input.Value
+			}
+		}
+	}
+	return ""
+}
+
+func (b *Bot) handlePropose(session *discordgo.Session, interaction *discordgo.InteractionCreate, executor *gnokey.TxExecutor, opts map[string]*discordgo.ApplicationCommandInteractionDataOption) {
+	requestID := opts["request-id"].StringValue()
+	value := opts["value"].StringValue()
+
+	bond, err := actions.ProposeValue(executor, requestID, value)
+	if err != nil {
+		b.reply(session, interaction, fmt.Sprintf("Propose failed: %v", err))
+		return
+	}
+	b.reply(session, interaction, fmt.Sprintf("Proposed **%s** for request **%s** (bond: %d ugnot)", value, requestID, bond))
+}
+
+func (b *Bot) handleDispute(session *discordgo.Session, interaction *discordgo.InteractionCreate, executor *gnokey.TxExecutor, opts map[string]*discordgo.ApplicationCommandInteractionDataOption) {
+	requestID := opts["request-id"].StringValue()
+
+	bond, err := actions.DisputeCreate(executor, requestID)
+	if err != nil {
+		b.reply(session, interaction, fmt.Sprintf("Dispute failed: %v", err))
+		return
+	}
+	b.reply(session, interaction, fmt.Sprintf("Dispute opened on request **%s** (bond: %d ugnot)", requestID, bond))
+}
+
+func (b *Bot) handleVoteCommit(session *discordgo.Session, interaction *discordgo.InteractionCreate, executor *gnokey.TxExecutor, requestID, value, passphrase string) {
+	hash, deadline, mnemonic, err := actions.VoteCommit(executor, requestID, value, "", passphrase)
+	if err != nil {
+		b.reply(session, interaction, fmt.Sprintf("Vote commit failed: %v", err))
+		return
+	}
+
+	msg := fmt.Sprintf("Vote committed on request **%s** (hash: %s, reveal before %s)", requestID, hash, deadline.Format("2006-01-02T15:04:05Z07:00"))
+	if mnemonic != "" {
+		msg += fmt.Sprintf("\n\n**New recovery seed generated - write these words down, this is the only way to recover your vote salts if the vault is lost:**\n%s", mnemonic)
+	}
+	b.reply(session, interaction, msg)
+}
+
+func (b *Bot) handleVoteReveal(session *discordgo.Session, interaction *discordgo.InteractionCreate, executor *gnokey.TxExecutor, requestID, passphrase string) {
+	v, err := vault.Open(executor.ChainID, executor.KeyName)
+	if err != nil {
+		b.reply(session, interaction, fmt.Sprintf("Vote reveal failed: %v", err))
+		return
+	}
+	if err := actions.RevealVote(executor, v, requestID, passphrase); err != nil {
+		b.reply(session, interaction, fmt.Sprintf("Vote reveal failed: %v", err))
+		return
+	}
+	b.reply(session, interaction, fmt.Sprintf("Vote revealed on request **%s**", requestID))
+}
+
+// reply sends an ephemeral response, since tx results may be sensitive to
+// the caller only. The passphrase modal response (InteractionResponseModal)
+// never goes through here - it has no content to leak in the first place.
+func (b *Bot) reply(session *discordgo.Session, interaction *discordgo.InteractionCreate, content string) {
+	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		fmt.Printf("Warning: failed to respond to interaction: %v\n", err)
+	}
+}
+
+func optionMap(options []*discordgo.ApplicationCommandInteractionDataOption) map[string]*discordgo.ApplicationCommandInteractionDataOption {
+	m := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, opt := range options {
+		m[opt.Name] = opt
+	}
+	return m
+}
+
+func interactionUserID(interaction *discordgo.InteractionCreate) string {
+	if interaction.Member != nil && interaction.Member.User != nil {
+		return interaction.Member.User.ID
+	}
+	if interaction.User != nil {
+		return interaction.User.ID
+	}
+	return ""
+}
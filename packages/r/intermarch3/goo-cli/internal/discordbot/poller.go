@@ -0,0 +1,138 @@
+// Package discordbot runs a Discord bot front-end for the oracle: it posts
+// channel notifications as requests move through Requested -> Proposed ->
+// Disputed -> Resolved, and exposes slash commands that drive the same
+// propose/dispute/vote-commit/vote-reveal actions as the `goo` CLI.
+package discordbot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/gnokey"
+)
+
+// poller watches request/dispute state on a timer and posts a channel
+// message the first time it observes each transition. It keeps no state
+// across process restarts, so transitions that happened while the bot was
+// down are not backfilled.
+type poller struct {
+	session         *discordgo.Session
+	executor        *gnokey.TxExecutor
+	notifyChannels  map[string]string
+	interval        time.Duration
+	seenRequests    map[string]bool
+	seenProposed    map[string]bool
+	seenDisputed    map[string]bool
+	warnedRevealing map[string]bool
+}
+
+func newPoller(session *discordgo.Session, executor *gnokey.TxExecutor, notifyChannels map[string]string, interval time.Duration) *poller {
+	return &poller{
+		session:         session,
+		executor:        executor,
+		notifyChannels:  notifyChannels,
+		interval:        interval,
+		seenRequests:    make(map[string]bool),
+		seenProposed:    make(map[string]bool),
+		seenDisputed:    make(map[string]bool),
+		warnedRevealing: make(map[string]bool),
+	}
+}
+
+// run polls until ctx is cancelled.
+func (p *poller) run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.pollOnce()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *poller) pollOnce() {
+	p.pollRequests()
+	p.pollDisputes()
+}
+
+func (p *poller) pollRequests() {
+	result, err := p.executor.QueryFunction("GetRequestsIds", []string{})
+	if err != nil {
+		return
+	}
+	ids, err := gnoabi.DecodeStringSlice(result)
+	if err != nil {
+		return
+	}
+
+	for _, id := range ids {
+		result, err := p.executor.QueryFunction("GetRequest", []string{id})
+		if err != nil {
+			continue
+		}
+		req, err := gnoabi.DecodeRequest(result)
+		if err != nil {
+			continue
+		}
+
+		if !p.seenRequests[id] {
+			p.notify("request_created", fmt.Sprintf(":new: New request **%s**: %s", req.ID, req.AncillaryData))
+			p.seenRequests[id] = true
+		}
+
+		if req.Proposer != "" && !p.seenProposed[id] {
+			p.notify("proposal_submitted", fmt.Sprintf(":memo: Proposal submitted for **%s**: %d by %s", req.ID, req.ProposedValue, req.Proposer))
+			p.seenProposed[id] = true
+		}
+	}
+}
+
+func (p *poller) pollDisputes() {
+	result, err := p.executor.QueryFunction("GetRequestsIdsWithState", []string{"Disputed"})
+	if err != nil {
+		return
+	}
+	ids, err := gnoabi.DecodeStringSlice(result)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	for _, id := range ids {
+		result, err := p.executor.QueryFunction("GetDispute", []string{id})
+		if err != nil {
+			continue
+		}
+		dispute, err := gnoabi.DecodeDispute(result)
+		if err != nil {
+			continue
+		}
+
+		if !p.seenDisputed[id] {
+			p.notify("dispute_opened", fmt.Sprintf(":warning: Dispute opened on **%s** by %s", dispute.RequestID, dispute.Disputer))
+			p.seenDisputed[id] = true
+		}
+
+		if !p.warnedRevealing[id] && !dispute.Resolved && dispute.RevealEndTime.After(now) && dispute.RevealEndTime.Sub(now) <= time.Hour {
+			p.notify("reveal_ending", fmt.Sprintf(":hourglass: Reveal window for **%s** closes at %s", dispute.RequestID, dispute.RevealEndTime.Format(time.RFC3339)))
+			p.warnedRevealing[id] = true
+		}
+	}
+}
+
+func (p *poller) notify(eventKind, message string) {
+	channelID, ok := p.notifyChannels[eventKind]
+	if !ok || channelID == "" {
+		return
+	}
+	p.session.ChannelMessageSend(channelID, message)
+}
@@ -0,0 +1,214 @@
+package gnoabi
+
+import (
+	"fmt"
+	"time"
+
+	"goo-cli/pkg/types"
+)
+
+// DecodeString decodes a `data: ("..." string)` query result.
+func DecodeString(output string) (string, error) {
+	n, err := ParseDataLine(output)
+	if err != nil {
+		return "", err
+	}
+	if n.Kind != KindString {
+		return "", fmt.Errorf("gnoabi: expected string, got %s", n.GoString())
+	}
+	return n.Str, nil
+}
+
+// DecodeInt64 decodes a `data: (123 int64)` query result.
+func DecodeInt64(output string) (int64, error) {
+	n, err := ParseDataLine(output)
+	if err != nil {
+		return 0, err
+	}
+	if n.Kind != KindInt64 && n.Kind != KindUint64 {
+		return 0, fmt.Errorf("gnoabi: expected int64, got %s", n.GoString())
+	}
+	return n.Int64(), nil
+}
+
+// DecodeStringSlice decodes a `data: (slice[("a" string),("b" string)] []string)` result.
+func DecodeStringSlice(output string) ([]string, error) {
+	n, err := ParseDataLine(output)
+	if err != nil {
+		return nil, err
+	}
+	if n.Kind != KindSlice {
+		return nil, fmt.Errorf("gnoabi: expected slice, got %s", n.GoString())
+	}
+	result := make([]string, 0, len(n.Items))
+	for _, item := range n.Items {
+		result = append(result, item.String())
+	}
+	return result, nil
+}
+
+// timeFromField decodes a time.Time field. Gno values of kind time.Time are
+// returned as a struct{(seconds int64),(nanos int64)} pair in this realm, or
+// as an unresolved ref(...) when the node hasn't been fully dereferenced by
+// qeval. The struct form and a bare int64/uint64 of seconds are decoded; a
+// ref (or anything else) yields the zero time.
+func timeFromField(n *Node) time.Time {
+	if n == nil {
+		return time.Time{}
+	}
+	if n.Kind == KindStruct && len(n.Items) >= 1 {
+		sec := n.Items[0].Int64()
+		var nsec int64
+		if len(n.Items) >= 2 {
+			nsec = n.Items[1].Int64()
+		}
+		return time.Unix(sec, nsec).UTC()
+	}
+	if n.Kind == KindInt64 || n.Kind == KindUint64 {
+		return time.Unix(n.Int64(), 0).UTC()
+	}
+	return time.Time{}
+}
+
+// addressFromField decodes a Gno address field, which may be a quoted
+// bech32 string or a bare identifier for the zero address.
+func addressFromField(n *Node) string {
+	if n == nil || n.Kind != KindString {
+		return ""
+	}
+	return n.Str
+}
+
+// requestFieldOrder documents the Request struct layout returned by the
+// realm's GetRequest, so index-based field access below stays auditable.
+var requestFieldOrder = []string{
+	"ID", "Requester", "CreatedAt", "AncillaryData", "YesNoQuestion",
+	"ProposedValue", "Proposer", "ProposerBond", "Disputer", "DisputerBond",
+	"ResolutionTime", "WinningValue", "State", "Deadline",
+}
+
+// DecodeRequest decodes the struct returned by GetRequest into a typed
+// types.Request, including real time.Time fields instead of "N/A" placeholders.
+func DecodeRequest(output string) (*types.Request, error) {
+	n, err := ParseDataLine(output)
+	if err != nil {
+		return nil, err
+	}
+	if n.Kind != KindStruct {
+		return nil, fmt.Errorf("gnoabi: expected struct, got %s", n.GoString())
+	}
+	if len(n.Items) < len(requestFieldOrder) {
+		return nil, fmt.Errorf("gnoabi: expected %d Request fields, got %d", len(requestFieldOrder), len(n.Items))
+	}
+
+	req := &types.Request{
+		ID:             n.Field(0).String(),
+		Requester:      addressFromField(n.Field(1)),
+		CreatedAt:      timeFromField(n.Field(2)),
+		AncillaryData:  n.Field(3).String(),
+		YesNoQuestion:  n.Field(4).IsBoolTrue(),
+		ProposedValue:  n.Field(5).Int64(),
+		Proposer:       addressFromField(n.Field(6)),
+		ProposerBond:   n.Field(7).Int64(),
+		Disputer:       addressFromField(n.Field(8)),
+		DisputerBond:   n.Field(9).Int64(),
+		ResolutionTime: timeFromField(n.Field(10)),
+		WinningValue:   n.Field(11).Int64(),
+		State:          parseState(n.Field(12).String()),
+		Deadline:       timeFromField(n.Field(13)),
+	}
+
+	return req, nil
+}
+
+func parseState(s string) types.RequestState {
+	switch s {
+	case "Proposed":
+		return types.StateProposed
+	case "Disputed":
+		return types.StateDisputed
+	case "Resolved":
+		return types.StateResolved
+	default:
+		return types.StateRequested
+	}
+}
+
+// disputeFieldOrder documents the Dispute struct layout returned by the
+// realm's GetDispute.
+var disputeFieldOrder = []string{
+	"RequestID", "Votes", "NbResolvedVotes", "Voters", "Resolved",
+	"WinningValue", "VoteEndTime", "RevealEndTime",
+}
+
+// DecodeDispute decodes the struct returned by GetDispute into a typed
+// types.Dispute, preserving per-vote records and voter addresses instead of
+// only counting slice elements.
+func DecodeDispute(output string) (*types.Dispute, error) {
+	n, err := ParseDataLine(output)
+	if err != nil {
+		return nil, err
+	}
+	if n.Kind != KindStruct {
+		return nil, fmt.Errorf("gnoabi: expected struct, got %s", n.GoString())
+	}
+	if len(n.Items) < len(disputeFieldOrder) {
+		return nil, fmt.Errorf("gnoabi: expected %d Dispute fields, got %d", len(disputeFieldOrder), len(n.Items))
+	}
+
+	votes := decodeVotes(n.Field(1))
+	var votesFor, votesAgainst int64
+	var revealed int64
+	for _, v := range votes {
+		if !v.Revealed {
+			continue
+		}
+		revealed++
+		if v.Value != 0 {
+			votesFor++
+		} else {
+			votesAgainst++
+		}
+	}
+
+	dispute := &types.Dispute{
+		RequestID:     n.Field(0).String(),
+		Votes:         votes,
+		TotalVotes:    int64(len(votes)),
+		VotesFor:      votesFor,
+		VotesAgainst:  votesAgainst,
+		Resolved:      n.Field(4).IsBoolTrue(),
+		WinningValue:  n.Field(5).Int64(),
+		VoteEndTime:   timeFromField(n.Field(6)),
+		RevealEndTime: timeFromField(n.Field(7)),
+	}
+
+	return dispute, nil
+}
+
+// decodeVotes decodes the []Vote slice field of a Dispute. Entries we can't
+// fully resolve (bare ref(...) placeholders) are surfaced with an empty
+// Voter rather than silently dropped, so callers can tell the difference
+// between "no votes" and "votes we couldn't decode".
+func decodeVotes(n *Node) []types.Vote {
+	if n == nil || n.Kind != KindSlice {
+		return nil
+	}
+	votes := make([]types.Vote, 0, len(n.Items))
+	for _, item := range n.Items {
+		if item.Kind == KindRef {
+			votes = append(votes, types.Vote{})
+			continue
+		}
+		if item.Kind != KindStruct || len(item.Items) < 4 {
+			continue
+		}
+		votes = append(votes, types.Vote{
+			Voter:    addressFromField(item.Field(0)),
+			Hash:     item.Field(1).String(),
+			Revealed: item.Field(2).IsBoolTrue(),
+			Value:    item.Field(3).Int64(),
+		})
+	}
+	return votes
+}
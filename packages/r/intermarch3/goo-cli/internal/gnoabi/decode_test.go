@@ -0,0 +1,130 @@
+package gnoabi
+
+import (
+	"testing"
+
+	"goo-cli/pkg/types"
+)
+
+func TestDecodeString(t *testing.T) {
+	s, err := DecodeString(`data: ("hello" string)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s != "hello" {
+		t.Fatalf("got %q, want %q", s, "hello")
+	}
+}
+
+func TestDecodeInt64(t *testing.T) {
+	n, err := DecodeInt64("height: 0\ndata: (2000000 int64)\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != 2000000 {
+		t.Fatalf("got %d, want %d", n, 2000000)
+	}
+}
+
+func TestDecodeStringSlice(t *testing.T) {
+	ids, err := DecodeStringSlice(`data: (slice[("a" string),("b" string)] []string)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Fatalf("got %v, want [a b]", ids)
+	}
+}
+
+func TestDecodeRequest(t *testing.T) {
+	output := `data: (struct{` +
+		`("0000001" string),` +
+		`("g1requester000000000000000000000000000" string),` +
+		`(struct{(1700000000 int64),(0 int64)} time.Time),` +
+		`("ancillary" string),` +
+		`(true bool),` +
+		`(1 int64),` +
+		`("g1proposer00000000000000000000000000000" string),` +
+		`(1000000 int64),` +
+		`("g1disputer00000000000000000000000000000" string),` +
+		`(2000000 int64),` +
+		`(struct{(1700001000 int64),(0 int64)} time.Time),` +
+		`(1 int64),` +
+		`("Disputed" string),` +
+		`(struct{(1700002000 int64),(0 int64)} time.Time)` +
+		`} Request)`
+
+	req, err := DecodeRequest(output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.ID != "0000001" {
+		t.Errorf("ID = %q, want %q", req.ID, "0000001")
+	}
+	if req.Requester != "g1requester000000000000000000000000000" {
+		t.Errorf("Requester = %q", req.Requester)
+	}
+	if req.State != types.StateDisputed {
+		t.Errorf("State = %v, want StateDisputed", req.State)
+	}
+	if req.ProposerBond != 1000000 {
+		t.Errorf("ProposerBond = %d, want 1000000", req.ProposerBond)
+	}
+}
+
+func TestDecodeRequestRejectsTooFewFields(t *testing.T) {
+	if _, err := DecodeRequest(`data: (struct{("0000001" string)} Request)`); err == nil {
+		t.Fatal("expected an error for a Request struct with too few fields")
+	}
+}
+
+func TestDecodeVotesFullyResolved(t *testing.T) {
+	n, err := Parse(`(slice[` +
+		`(struct{("g1voter0000000000000000000000000000000" string),("abc123" string),(true bool),(1 int64)} Vote)` +
+		`] []Vote)`)
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+
+	votes := decodeVotes(n)
+	if len(votes) != 1 {
+		t.Fatalf("got %d votes, want 1", len(votes))
+	}
+	if votes[0].Voter != "g1voter0000000000000000000000000000000" || votes[0].Hash != "abc123" || !votes[0].Revealed || votes[0].Value != 1 {
+		t.Fatalf("unexpected vote: %+v", votes[0])
+	}
+}
+
+// TestDecodeVotesDropsShortStructs is a regression test for an off-by-one in
+// decodeVotes's length guard: Value reads item.Field(3) (the 4th field), so
+// a struct with only 3 fields must be dropped, not decoded with a
+// silently-zero Value (unlike a bare ref(...), which decodeVotes keeps as an
+// empty placeholder).
+func TestDecodeVotesDropsShortStructs(t *testing.T) {
+	n, err := Parse(`(slice[` +
+		`(struct{("g1voter0000000000000000000000000000000" string),("abc123" string),(true bool)} Vote)` +
+		`] []Vote)`)
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+
+	votes := decodeVotes(n)
+	if len(votes) != 0 {
+		t.Fatalf("got %d votes, want 0 - a 3-field struct should be dropped, not decoded with Value=0: %+v", len(votes), votes)
+	}
+}
+
+func TestDecodeVotesSurfacesUnresolvedRef(t *testing.T) {
+	n, err := Parse(`(slice[(ref(@"gno.land/r/intermarch3/oracle".voters) *avl.Tree)] []Vote)`)
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+
+	votes := decodeVotes(n)
+	if len(votes) != 1 {
+		t.Fatalf("got %d votes, want 1 (surfaced as an empty placeholder)", len(votes))
+	}
+	if votes[0] != (types.Vote{}) {
+		t.Fatalf("expected an empty placeholder Vote, got %+v", votes[0])
+	}
+}
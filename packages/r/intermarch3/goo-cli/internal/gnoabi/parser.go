@@ -0,0 +1,255 @@
+package gnoabi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parser is a small recursive-descent reader over the "data:" payload of a
+// gnokey query response. It is not a general amino decoder - it only
+// understands the subset of literal/slice/struct/ref productions that
+// gnokey's textual qeval output actually emits.
+type parser struct {
+	src []rune
+	pos int
+}
+
+// ParseDataLine extracts the value following "data:" in a gnokey query
+// response and decodes it into a Node tree.
+func ParseDataLine(output string) (*Node, error) {
+	for _, line := range strings.Split(output, "\n") {
+		if rest, ok := strings.CutPrefix(line, "data:"); ok {
+			return Parse(strings.TrimSpace(rest))
+		}
+	}
+	return nil, fmt.Errorf("gnoabi: no data field found in query output")
+}
+
+// Parse decodes a single value expression, e.g. `("hello" string)`,
+// `(slice[(1 int64)] []int64)`, or `nil`.
+func Parse(s string) (*Node, error) {
+	p := &parser{src: []rune(strings.TrimSpace(s))}
+	n, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos != len(p.src) {
+		return nil, fmt.Errorf("gnoabi: trailing input at offset %d: %q", p.pos, string(p.src[p.pos:]))
+	}
+	return n, nil
+}
+
+func (p *parser) peek() rune {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *parser) consume(tok string) bool {
+	if strings.HasPrefix(string(p.src[p.pos:]), tok) {
+		p.pos += len(tok)
+		return true
+	}
+	return false
+}
+
+// parseValue parses "(" expr " " type ")" or the literal "nil".
+func (p *parser) parseValue() (*Node, error) {
+	p.skipSpace()
+	if p.consume("nil") {
+		return &Node{Kind: KindNil}, nil
+	}
+	if p.peek() != '(' {
+		return nil, fmt.Errorf("gnoabi: expected '(' at offset %d, got %q", p.pos, string(p.src[p.pos:]))
+	}
+	p.pos++ // consume '('
+
+	node, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpace()
+	typ := p.readTypeToken()
+	node.Type = typ
+
+	p.skipSpace()
+	if p.peek() != ')' {
+		return nil, fmt.Errorf("gnoabi: expected ')' at offset %d, got %q", p.pos, string(p.src[p.pos:]))
+	}
+	p.pos++ // consume ')'
+
+	return node, nil
+}
+
+// parseExpr parses the expression inside a value's parens, before the
+// trailing type token: a quoted string, a bare literal (bool/number/bare
+// address), a slice[...], a struct{...}, or a ref(...).
+func (p *parser) parseExpr() (*Node, error) {
+	p.skipSpace()
+	switch {
+	case p.peek() == '"':
+		s, err := p.readQuoted()
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Kind: KindString, Str: s}, nil
+
+	case strings.HasPrefix(string(p.src[p.pos:]), "slice["):
+		p.pos += len("slice[")
+		items, err := p.parseList(']')
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Kind: KindSlice, Items: items}, nil
+
+	case strings.HasPrefix(string(p.src[p.pos:]), "struct{"):
+		p.pos += len("struct{")
+		items, err := p.parseList('}')
+		if err != nil {
+			return nil, err
+		}
+		return &Node{Kind: KindStruct, Items: items}, nil
+
+	case strings.HasPrefix(string(p.src[p.pos:]), "ref("):
+		p.pos += len("ref(")
+		raw := p.readUntilMatchingParen()
+		return &Node{Kind: KindRef, Raw: raw}, nil
+
+	default:
+		return p.parseBareLiteral()
+	}
+}
+
+// parseList reads comma-separated values up to (and consuming) closeCh.
+func (p *parser) parseList(closeCh rune) ([]*Node, error) {
+	var items []*Node
+	p.skipSpace()
+	if p.peek() == closeCh {
+		p.pos++
+		return items, nil
+	}
+	for {
+		item, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		p.skipSpace()
+		if p.peek() == ',' {
+			p.pos++
+			continue
+		}
+		if p.peek() == closeCh {
+			p.pos++
+			return items, nil
+		}
+		return nil, fmt.Errorf("gnoabi: expected ',' or %q at offset %d, got %q", closeCh, p.pos, string(p.src[p.pos:]))
+	}
+}
+
+// readQuoted reads a double-quoted Go-style string literal.
+func (p *parser) readQuoted() (string, error) {
+	if p.peek() != '"' {
+		return "", fmt.Errorf("gnoabi: expected '\"' at offset %d", p.pos)
+	}
+	start := p.pos
+	p.pos++
+	for p.pos < len(p.src) {
+		if p.src[p.pos] == '\\' {
+			p.pos += 2
+			continue
+		}
+		if p.src[p.pos] == '"' {
+			p.pos++
+			raw := string(p.src[start:p.pos])
+			unquoted, err := strconv.Unquote(raw)
+			if err != nil {
+				// Fall back to the raw contents without the surrounding quotes.
+				return raw[1 : len(raw)-1], nil
+			}
+			return unquoted, nil
+		}
+		p.pos++
+	}
+	return "", fmt.Errorf("gnoabi: unterminated string literal at offset %d", start)
+}
+
+// readUntilMatchingParen reads the contents of a ref(...) expression,
+// tracking nested parens so embedded values don't confuse the boundary.
+func (p *parser) readUntilMatchingParen() string {
+	start := p.pos
+	depth := 1
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				raw := string(p.src[start:p.pos])
+				p.pos++ // consume the closing ')'
+				return raw
+			}
+		}
+		p.pos++
+	}
+	return string(p.src[start:])
+}
+
+// readTypeToken reads the type annotation that follows an expression -
+// everything up to the closing ')' of the enclosing value, since Gno type
+// names ("[]string", "time.Time", "*avl.Tree") don't contain parens.
+func (p *parser) readTypeToken() string {
+	start := p.pos
+	for p.pos < len(p.src) && p.src[p.pos] != ')' {
+		p.pos++
+	}
+	return strings.TrimSpace(string(p.src[start:p.pos]))
+}
+
+// parseBareLiteral parses an unquoted literal: true/false, a number, or a
+// bare token such as a g1... address or the zero address placeholder.
+func (p *parser) parseBareLiteral() (*Node, error) {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == ' ' || c == ')' || c == ',' || c == ']' || c == '}' {
+			break
+		}
+		p.pos++
+	}
+	if p.pos == start {
+		return nil, fmt.Errorf("gnoabi: empty literal at offset %d", start)
+	}
+	tok := string(p.src[start:p.pos])
+
+	switch tok {
+	case "true":
+		return &Node{Kind: KindBool, Bool: true}, nil
+	case "false":
+		return &Node{Kind: KindBool, Bool: false}, nil
+	}
+
+	if i, err := strconv.ParseInt(tok, 10, 64); err == nil {
+		return &Node{Kind: KindInt64, Int: i}, nil
+	}
+	if u, err := strconv.ParseUint(tok, 10, 64); err == nil {
+		return &Node{Kind: KindUint64, Uint: u}, nil
+	}
+	if f, err := strconv.ParseFloat(tok, 64); err == nil {
+		return &Node{Kind: KindFloat64, Float: f}, nil
+	}
+
+	// Bare identifier (e.g. an unquoted address) - keep it as a string.
+	return &Node{Kind: KindString, Str: tok}, nil
+}
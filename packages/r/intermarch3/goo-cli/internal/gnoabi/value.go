@@ -0,0 +1,125 @@
+// Package gnoabi decodes the textual amino-ish values returned by
+// `gnokey query vm/qeval` into a typed tree that callers can walk without
+// hand-rolling positional string scraping.
+//
+// Grammar (informal):
+//
+//	value    := "(" expr " " type ")" | "nil"
+//	expr     := string | number | "true" | "false" | slice | structv | ref
+//	slice    := "slice[" (value ("," value)*)? "]"
+//	structv  := "struct{" (value ("," value)*)? "}"
+//	ref      := "ref(" text with balanced parentheses ")"
+//	string   := '"' ... '"'
+//	type     := everything up to the value's closing ")"
+//
+// A ref is an unresolved pointer into the node's memory graph (e.g. an
+// avl.Tree of voters); we surface it as KindRef with its raw contents so
+// callers can decide whether it's relevant, rather than silently dropping
+// it as "N/A".
+package gnoabi
+
+import "fmt"
+
+// Kind identifies the shape of a decoded Node.
+type Kind int
+
+const (
+	KindNil Kind = iota
+	KindString
+	KindBool
+	KindInt64
+	KindUint64
+	KindFloat64
+	KindSlice
+	KindStruct
+	KindRef
+)
+
+// Node is one decoded value from a gnokey query result.
+type Node struct {
+	Kind  Kind
+	Type  string // the trailing type annotation, e.g. "string", "[]Vote", "MyStruct"
+	Str   string
+	Bool  bool
+	Int   int64
+	Uint  uint64
+	Float float64
+	Items []*Node // elements of a slice, or fields of a struct
+	Raw   string  // raw contents for KindRef (or anything we couldn't resolve further)
+}
+
+// Field returns the i-th struct field, or nil if out of range or not a struct.
+func (n *Node) Field(i int) *Node {
+	if n == nil || n.Kind != KindStruct || i < 0 || i >= len(n.Items) {
+		return nil
+	}
+	return n.Items[i]
+}
+
+// String returns the string value, or "" if n isn't a string node.
+func (n *Node) String() string {
+	if n == nil || n.Kind != KindString {
+		return ""
+	}
+	return n.Str
+}
+
+// Int64 returns the int64 value, or 0 if n isn't numeric.
+func (n *Node) Int64() int64 {
+	if n == nil {
+		return 0
+	}
+	switch n.Kind {
+	case KindInt64:
+		return n.Int
+	case KindUint64:
+		return int64(n.Uint)
+	}
+	return 0
+}
+
+// IsBoolTrue returns true only if n is a KindBool node holding true.
+func (n *Node) IsBoolTrue() bool {
+	return n != nil && n.Kind == KindBool && n.Bool
+}
+
+// Slice returns the elements of a slice node, or nil if n isn't a slice.
+func (n *Node) Slice() []*Node {
+	if n == nil || n.Kind != KindSlice {
+		return nil
+	}
+	return n.Items
+}
+
+// IsNil reports whether n represents the literal "nil".
+func (n *Node) IsNil() bool {
+	return n == nil || n.Kind == KindNil
+}
+
+// GoString renders the node back into a debug-friendly form, mostly for
+// error messages when a decoder encounters an unexpected shape.
+func (n *Node) GoString() string {
+	if n == nil {
+		return "<nil>"
+	}
+	switch n.Kind {
+	case KindNil:
+		return "nil"
+	case KindString:
+		return fmt.Sprintf("%q", n.Str)
+	case KindBool:
+		return fmt.Sprintf("%v", n.Bool)
+	case KindInt64:
+		return fmt.Sprintf("%d", n.Int)
+	case KindUint64:
+		return fmt.Sprintf("%d", n.Uint)
+	case KindFloat64:
+		return fmt.Sprintf("%g", n.Float)
+	case KindRef:
+		return fmt.Sprintf("ref(%s)", n.Raw)
+	case KindSlice, KindStruct:
+		return fmt.Sprintf("%s{%d items}", n.Type, len(n.Items))
+	default:
+		return "?"
+	}
+}
@@ -2,7 +2,6 @@ package gnokey
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -10,6 +9,8 @@ import (
 	"time"
 
 	"goo-cli/internal/config"
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/metrics"
 	"goo-cli/internal/utils"
 )
 
@@ -22,6 +23,13 @@ type TxExecutor struct {
 	GasFee    string
 	GasWanted int64
 	Verbose   bool
+
+	// KeyStore, when set, supplies KeyName's passphrase non-interactively
+	// for CallFunction/runSigningCommand instead of prompting on stdin.
+	// Nil means "consult the default (env-based) store, and fall back to an
+	// interactive prompt if it has no entry". SignTxFile takes no executor
+	// and always consults the default store.
+	KeyStore KeyStore
 }
 
 // NewExecutor creates a new TxExecutor from config
@@ -39,6 +47,9 @@ func NewExecutor(cfg *config.Config, verbose bool) *TxExecutor {
 
 // CallFunction executes a function call (transaction)
 func (e *TxExecutor) CallFunction(funcName string, args []string, sendCoins string) error {
+	start := time.Now()
+	defer func() { metrics.ObserveGnokeyCall(funcName, "tx", time.Since(start)) }()
+
 	// Build command arguments
 	cmdArgs := []string{
 		"maketx", "call",
@@ -69,9 +80,17 @@ func (e *TxExecutor) CallFunction(funcName string, args []string, sendCoins stri
 	printCommand("gnokey", cmdArgs)
 	fmt.Println()
 
-	// Execute the command with inherited stdin for interactive password input
 	cmd := exec.Command("gnokey", cmdArgs...)
-	cmd.Stdin = os.Stdin
+
+	// Feed the passphrase non-interactively when a KeyStore has one for
+	// e.KeyName (CI, daemons); otherwise inherit stdin so gnokey can
+	// prompt the user directly.
+	passphrase, nonInteractive := passphraseFor(e.KeyStore, e.KeyName)
+	if nonInteractive {
+		cmd.Stdin = strings.NewReader(passphrase + "\n")
+	} else {
+		cmd.Stdin = os.Stdin
+	}
 
 	// Handle stdout and stderr based on verbose mode
 	var stdoutBuf, stderrBuf bytes.Buffer
@@ -83,8 +102,11 @@ func (e *TxExecutor) CallFunction(funcName string, args []string, sendCoins stri
 		// In non-verbose mode, capture both stdout and stderr
 		cmd.Stdout = &stdoutBuf
 		cmd.Stderr = &stderrBuf
-		// Print password prompt since stderr is not shown
-		fmt.Print("Password: ")
+		// Print password prompt since stderr is not shown, unless the
+		// passphrase was already supplied non-interactively
+		if !nonInteractive {
+			fmt.Print("Password: ")
+		}
 	}
 
 	if err := cmd.Run(); err != nil {
@@ -111,6 +133,9 @@ func (e *TxExecutor) CallFunction(funcName string, args []string, sendCoins stri
 
 // QueryFunction executes a query (read-only call)
 func (e *TxExecutor) QueryFunction(funcName string, args []string) (string, error) {
+	start := time.Now()
+	defer func() { metrics.ObserveGnokeyCall(funcName, "query", time.Since(start)) }()
+
 	// Build the query path with function call syntax
 	queryPath := fmt.Sprintf("%s.%s(", e.RealmPath, funcName)
 	if len(args) > 0 {
@@ -179,101 +204,10 @@ func (e *TxExecutor) QueryInt64(funcName string) (int64, error) {
 		return 0, err
 	}
 
-	// Parse the result to extract the int64 value
-	// The output format is like: "height: 0\ndata: (2000000 int64)\n"
-	var value int64
-	lines := strings.Split(result, "\n")
-	for _, line := range lines {
-		if strings.HasPrefix(line, "data:") {
-			// Extract the value from format: "data: (value type)"
-			line = strings.TrimPrefix(line, "data:")
-			line = strings.TrimSpace(line)
-			// Remove parentheses and split
-			line = strings.Trim(line, "()")
-			parts := strings.Fields(line)
-			if len(parts) >= 1 {
-				_, err = fmt.Sscanf(parts[0], "%d", &value)
-				if err == nil {
-					return value, nil
-				}
-			}
-		}
-	}
-	return 0, utils.ParseContractError(fmt.Errorf("failed to parse int64 from query result: %s", result))
-}
-
-// VoteData represents stored vote information
-type VoteData struct {
-	RequestID string `json:"request_id"`
-	Value     string `json:"value"`
-	Salt      string `json:"salt"`
-	Hash      string `json:"hash"`
-	Timestamp string `json:"timestamp"`
-}
-
-// SaveVoteLocally saves vote data to local storage
-func SaveVoteLocally(requestID, value, salt, hash string) error {
-	// Get home directory
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
-	}
-
-	// Create votes directory
-	votesDir := fmt.Sprintf("%s/.goo/votes", homeDir)
-	if err := os.MkdirAll(votesDir, 0755); err != nil {
-		return fmt.Errorf("failed to create votes directory: %w", err)
-	}
-
-	// Create vote data
-	voteData := VoteData{
-		RequestID: requestID,
-		Value:     value,
-		Salt:      salt,
-		Hash:      hash,
-		Timestamp: time.Now().Format(time.RFC3339),
-	}
-
-	// Marshal to JSON
-	data, err := json.MarshalIndent(voteData, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal vote data: %w", err)
-	}
-
-	// Write to file
-	filePath := fmt.Sprintf("%s/%s.json", votesDir, requestID)
-	if err := os.WriteFile(filePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write vote file: %w", err)
-	}
-
-	utils.PrintInfo(fmt.Sprintf("Vote data saved to: %s", filePath))
-	return nil
-}
-
-// LoadVoteLocally loads vote data from local storage
-func LoadVoteLocally(requestID string) (value, salt string, err error) {
-	// Get home directory
-	homeDir, err := os.UserHomeDir()
+	// Decode the result, e.g. "height: 0\ndata: (2000000 int64)\n"
+	value, err := gnoabi.DecodeInt64(result)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get home directory: %w", err)
+		return 0, utils.ParseContractError(fmt.Errorf("failed to decode int64 from query result: %w", err))
 	}
-
-	// Read vote file
-	filePath := fmt.Sprintf("%s/.goo/votes/%s.json", homeDir, requestID)
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to read vote file: %w (did you commit a vote for this request?)", err)
-	}
-
-	// Unmarshal JSON
-	var voteData VoteData
-	if err := json.Unmarshal(data, &voteData); err != nil {
-		return "", "", fmt.Errorf("failed to parse vote data: %w", err)
-	}
-
-	if voteData.Value == "" || voteData.Salt == "" {
-		return "", "", fmt.Errorf("vote data is incomplete")
-	}
-
-	return voteData.Value, voteData.Salt, nil
+	return value, nil
 }
@@ -0,0 +1,18 @@
+package gnokey
+
+// Executor is the surface pkg/gooclient and internal/commands drive the
+// chain through: submit a call, run a read-only query, and decode a
+// single int64 out of one. *TxExecutor (shell out to the gnokey CLI) and
+// *RPCExecutor (talk to the RPC endpoint directly for queries) both
+// satisfy it, so callers can swap implementations without caring which
+// one is underneath.
+type Executor interface {
+	CallFunction(funcName string, args []string, sendCoins string) error
+	QueryFunction(funcName string, args []string) (string, error)
+	QueryInt64(funcName string) (int64, error)
+}
+
+var (
+	_ Executor = (*TxExecutor)(nil)
+	_ Executor = (*RPCExecutor)(nil)
+)
@@ -0,0 +1,177 @@
+package gnokey
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// defaultGasSampleBlocks is used when config doesn't set gas_sample_blocks.
+const defaultGasSampleBlocks = 20
+
+// GasEstimate is the result of EstimateGas: the observed block gas
+// utilization over the sample and the fee it implies.
+type GasEstimate struct {
+	SampledBlocks  int
+	Utilization    float64 // average gas_used / max_gas over the sample, in [0,1]
+	Multiplier     float64 // fee multiplier derived from Utilization
+	BaseFeeUgnot   int64
+	EstimatedUgnot int64
+}
+
+// EstimateGas samples the last sampleBlocks blocks (default 20 when <= 0)
+// from remote's RPC endpoint, computes the moving average of
+// gas_used/max_gas across their transactions, and scales baseFeeUgnot by a
+// piecewise curve: flat at 1x below 50% block gas utilization, ramping
+// linearly up to 3x at 90%+ utilization.
+func EstimateGas(remote string, sampleBlocks int, baseFeeUgnot int64) (*GasEstimate, error) {
+	if sampleBlocks <= 0 {
+		sampleBlocks = defaultGasSampleBlocks
+	}
+
+	base, err := rpcBaseURL(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	latestHeight, err := latestBlockHeight(base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query latest block height: %w", err)
+	}
+
+	maxGas, err := blockMaxGas(base, latestHeight)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query consensus params: %w", err)
+	}
+	if maxGas <= 0 {
+		return nil, fmt.Errorf("chain reports no block gas limit (max_gas=%d); can't estimate utilization", maxGas)
+	}
+
+	fromHeight := latestHeight - int64(sampleBlocks) + 1
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+
+	var totalUtilization float64
+	sampled := 0
+	for h := fromHeight; h <= latestHeight; h++ {
+		used, err := blockGasUsed(base, h)
+		if err != nil {
+			continue
+		}
+		totalUtilization += float64(used) / float64(maxGas)
+		sampled++
+	}
+	if sampled == 0 {
+		return nil, fmt.Errorf("failed to sample any of the last %d blocks", sampleBlocks)
+	}
+
+	utilization := totalUtilization / float64(sampled)
+	multiplier := gasMultiplier(utilization)
+
+	return &GasEstimate{
+		SampledBlocks:  sampled,
+		Utilization:    utilization,
+		Multiplier:     multiplier,
+		BaseFeeUgnot:   baseFeeUgnot,
+		EstimatedUgnot: int64(float64(baseFeeUgnot) * multiplier),
+	}, nil
+}
+
+// gasMultiplier implements the piecewise fee curve described in
+// EstimateGas's doc comment.
+func gasMultiplier(utilization float64) float64 {
+	const (
+		rampStart   = 0.5
+		rampEnd     = 0.9
+		maxMultiple = 3.0
+	)
+	switch {
+	case utilization <= rampStart:
+		return 1.0
+	case utilization >= rampEnd:
+		return maxMultiple
+	default:
+		return 1.0 + (utilization-rampStart)/(rampEnd-rampStart)*(maxMultiple-1.0)
+	}
+}
+
+// rpcBaseURL converts a gnokey-style remote ("tcp://host:port") into an
+// http(s) base URL. gnokey itself doesn't expose block/consensus-params
+// queries, so EstimateGas talks to the node's Tendermint2 RPC directly.
+func rpcBaseURL(remote string) (string, error) {
+	switch {
+	case strings.HasPrefix(remote, "tcp://"):
+		return "http://" + strings.TrimPrefix(remote, "tcp://"), nil
+	case strings.HasPrefix(remote, "http://"), strings.HasPrefix(remote, "https://"):
+		return remote, nil
+	default:
+		return "", fmt.Errorf("unrecognized remote scheme: %s", remote)
+	}
+}
+
+func rpcGet(base, path string, out interface{}) error {
+	resp, err := http.Get(base + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+func latestBlockHeight(base string) (int64, error) {
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err := rpcGet(base, "/status", &status); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+func blockMaxGas(base string, height int64) (int64, error) {
+	var params struct {
+		Result struct {
+			ConsensusParams struct {
+				Block struct {
+					MaxGas string `json:"max_gas"`
+				} `json:"block"`
+			} `json:"consensus_params"`
+		} `json:"result"`
+	}
+	if err := rpcGet(base, fmt.Sprintf("/consensus_params?height=%d", height), &params); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(params.Result.ConsensusParams.Block.MaxGas, 10, 64)
+}
+
+func blockGasUsed(base string, height int64) (int64, error) {
+	var results struct {
+		Result struct {
+			Results struct {
+				DeliverTx []struct {
+					GasUsed string `json:"gas_used"`
+				} `json:"deliver_tx"`
+			} `json:"results"`
+		} `json:"result"`
+	}
+	if err := rpcGet(base, fmt.Sprintf("/block_results?height=%d", height), &results); err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, tx := range results.Result.Results.DeliverTx {
+		used, err := strconv.ParseInt(tx.GasUsed, 10, 64)
+		if err != nil {
+			continue
+		}
+		total += used
+	}
+	return total, nil
+}
@@ -0,0 +1,66 @@
+package gnokey
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// KeyStore resolves the passphrase for a gnokey keyring entry
+// non-interactively, so CI pipelines and long-running daemons (the bot,
+// goo serve, goo vote watch) don't need a human typing at a password
+// prompt for every signed transaction. Passphrase's second return value is
+// false when the store has no entry for keyName, in which case callers
+// fall back to the normal interactive prompt.
+type KeyStore interface {
+	Passphrase(keyName string) (string, bool)
+}
+
+// EnvKeyStore resolves a key's passphrase from the environment variable
+// named Prefix (default "GOO_KEYPASS_") followed by the key name
+// uppercased, e.g. GOO_KEYPASS_MYKEY.
+type EnvKeyStore struct {
+	Prefix string
+}
+
+// Passphrase implements KeyStore.
+func (s EnvKeyStore) Passphrase(keyName string) (string, bool) {
+	prefix := s.Prefix
+	if prefix == "" {
+		prefix = "GOO_KEYPASS_"
+	}
+	return os.LookupEnv(prefix + strings.ToUpper(keyName))
+}
+
+// FileKeyStore resolves a key's passphrase by reading
+// <Dir>/<keyName>.pass, for setups (e.g. a mounted Kubernetes secret) that
+// prefer a file over an environment variable.
+type FileKeyStore struct {
+	Dir string
+}
+
+// Passphrase implements KeyStore.
+func (s FileKeyStore) Passphrase(keyName string) (string, bool) {
+	data, err := os.ReadFile(filepath.Join(s.Dir, keyName+".pass"))
+	if err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(string(data)), true
+}
+
+// defaultKeyStore is consulted whenever a TxExecutor/SignTxFile call
+// doesn't set an explicit KeyStore, so GOO_KEYPASS_* just works without
+// any extra configuration.
+func defaultKeyStore() KeyStore {
+	return EnvKeyStore{}
+}
+
+// passphraseFor resolves keyName's passphrase from ks (or the default
+// store when ks is nil). ok is false when no non-interactive passphrase
+// is available and the caller should fall back to prompting.
+func passphraseFor(ks KeyStore, keyName string) (passphrase string, ok bool) {
+	if ks == nil {
+		ks = defaultKeyStore()
+	}
+	return ks.Passphrase(keyName)
+}
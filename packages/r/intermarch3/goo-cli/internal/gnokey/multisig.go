@@ -0,0 +1,160 @@
+package gnokey
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"goo-cli/internal/utils"
+)
+
+// GenerateUnsignedTx builds an unsigned transaction for funcName without
+// broadcasting it, the first step of the offline multisig flow: generate ->
+// sign (once per signer) -> multisign -> broadcast. When outPath is empty
+// the unsigned tx is only returned, not written to disk.
+func (e *TxExecutor) GenerateUnsignedTx(funcName string, args []string, sendCoins, outPath string) (string, error) {
+	cmdArgs := []string{
+		"maketx", "call",
+		"--pkgpath", e.RealmPath,
+		"--func", funcName,
+		"--gas-fee", e.GasFee,
+		"--gas-wanted", fmt.Sprintf("%d", e.GasWanted),
+		"--broadcast=false",
+		"--chainid", e.ChainID,
+		"--remote", e.Remote,
+	}
+
+	for _, arg := range args {
+		cmdArgs = append(cmdArgs, "--args", arg)
+	}
+	if sendCoins != "" {
+		cmdArgs = append(cmdArgs, "--send", sendCoins)
+	}
+	if outPath != "" {
+		cmdArgs = append(cmdArgs, "-o", outPath)
+	}
+	cmdArgs = append(cmdArgs, e.KeyName)
+
+	fmt.Println("Executing:")
+	printCommand("gnokey", cmdArgs)
+	fmt.Println()
+
+	output, err := e.runSigningCommand(cmdArgs)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate unsigned tx: %w", err)
+	}
+	return output, nil
+}
+
+// SignTx produces e's signer's partial signature over the unsigned
+// transaction at unsignedTxPath, writing it to outPath. `gnokey sign` signs
+// its --tx-path document in place (there's no -o/output flag), so outPath
+// starts as a copy of unsignedTxPath and is then signed where it sits -
+// mirroring SignTxFile's invocation shape in offline.go, since both shell
+// out to the same `gnokey sign <key-name>` command.
+func (e *TxExecutor) SignTx(unsignedTxPath, outPath string) error {
+	unsigned, err := os.ReadFile(unsignedTxPath)
+	if err != nil {
+		return fmt.Errorf("failed to read unsigned tx: %w", err)
+	}
+	if err := os.WriteFile(outPath, unsigned, 0644); err != nil {
+		return fmt.Errorf("failed to prepare signed tx file: %w", err)
+	}
+
+	cmdArgs := []string{
+		"sign",
+		"--tx-path", outPath,
+		"--chainid", e.ChainID,
+		e.KeyName,
+	}
+
+	fmt.Println("Executing:")
+	printCommand("gnokey", cmdArgs)
+	fmt.Println()
+
+	_, err = e.runSigningCommand(cmdArgs)
+	if err != nil {
+		return fmt.Errorf("failed to sign tx: %w", err)
+	}
+	return nil
+}
+
+// MultisignTx combines the partial signatures at sigPaths into a fully
+// signed transaction at outPath, using e.KeyName as the multisig account.
+func (e *TxExecutor) MultisignTx(unsignedTxPath string, sigPaths []string, outPath string) error {
+	cmdArgs := []string{
+		"multisign",
+		"--keyname", e.KeyName,
+		"--docpath", unsignedTxPath,
+	}
+	for _, sigPath := range sigPaths {
+		cmdArgs = append(cmdArgs, "--signature", sigPath)
+	}
+	cmdArgs = append(cmdArgs, "-o", outPath)
+
+	fmt.Println("Executing:")
+	printCommand("gnokey", cmdArgs)
+	fmt.Println()
+
+	_, err := e.runSigningCommand(cmdArgs)
+	if err != nil {
+		return fmt.Errorf("failed to combine signatures: %w", err)
+	}
+	return nil
+}
+
+// BroadcastTx submits a fully signed transaction file to the chain.
+func (e *TxExecutor) BroadcastTx(signedTxPath string) error {
+	cmdArgs := []string{"broadcast", signedTxPath, "--remote", e.Remote}
+
+	fmt.Println("Executing:")
+	printCommand("gnokey", cmdArgs)
+	fmt.Println()
+
+	_, err := e.runSigningCommand(cmdArgs)
+	if err != nil {
+		return fmt.Errorf("failed to broadcast tx: %w", err)
+	}
+	return nil
+}
+
+// runSigningCommand shells out to gnokey with inherited stdin (so gnokey can
+// interactively prompt for the signer's password), mirroring CallFunction's
+// verbose/non-verbose output handling.
+func (e *TxExecutor) runSigningCommand(cmdArgs []string) (string, error) {
+	cmd := exec.Command("gnokey", cmdArgs...)
+
+	passphrase, nonInteractive := passphraseFor(e.KeyStore, e.KeyName)
+	if nonInteractive {
+		cmd.Stdin = strings.NewReader(passphrase + "\n")
+	} else {
+		cmd.Stdin = os.Stdin
+	}
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+	if e.Verbose {
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+	} else {
+		cmd.Stdout = &stdoutBuf
+		cmd.Stderr = &stderrBuf
+		if !nonInteractive {
+			fmt.Print("Password: ")
+		}
+	}
+
+	err := cmd.Run()
+	if !e.Verbose {
+		fmt.Println()
+	}
+	if err != nil {
+		if !e.Verbose && stderrBuf.Len() > 0 {
+			return "", utils.ParseContractError(fmt.Errorf("%s", stderrBuf.String()))
+		}
+		return "", err
+	}
+
+	return stdoutBuf.String(), nil
+}
@@ -0,0 +1,129 @@
+package gnokey
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"goo-cli/internal/utils"
+)
+
+// BuildUnsignedTx shells out to `gnokey maketx call --broadcast=false` to
+// produce an unsigned transaction document at outPath. Unlike CallFunction,
+// this doesn't need fromAddress's private key to be present on this
+// machine: the resulting file can be carried to an air-gapped machine and
+// signed there with SignTxFile, keeping the signing key off any
+// network-connected host.
+func (e *TxExecutor) BuildUnsignedTx(funcName string, args []string, sendCoins, fromAddress, outPath string) error {
+	cmdArgs := []string{
+		"maketx", "call",
+		"--pkgpath", e.RealmPath,
+		"--func", funcName,
+		"--gas-fee", e.GasFee,
+		"--gas-wanted", fmt.Sprintf("%d", e.GasWanted),
+		"--chainid", e.ChainID,
+		"--remote", e.Remote,
+		"--broadcast=false",
+		"-o", outPath,
+	}
+
+	for _, arg := range args {
+		cmdArgs = append(cmdArgs, "--args", arg)
+	}
+	if sendCoins != "" {
+		cmdArgs = append(cmdArgs, "--send", sendCoins)
+	}
+	cmdArgs = append(cmdArgs, fromAddress)
+
+	if e.Verbose {
+		fmt.Println("Executing:")
+		printCommand("gnokey", cmdArgs)
+		fmt.Println()
+	}
+
+	cmd := exec.Command("gnokey", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if e.Verbose {
+		fmt.Println(string(output))
+	}
+	if err != nil {
+		return utils.ParseContractError(fmt.Errorf("failed to build unsigned tx: %w", err))
+	}
+
+	return nil
+}
+
+// SignTxFile signs the unsigned tx at path in place using the local
+// keyring entry named keyName. It makes no network call of its own - the
+// account number and sequence gnokey needs were already baked into the tx
+// document by BuildUnsignedTx - but `gnokey sign` still checks chainID
+// against the document's signed bytes, so this is safe to run on an
+// air-gapped machine as long as chainID matches what BuildUnsignedTx used.
+func SignTxFile(path, keyName, chainID string, verbose bool) error {
+	cmdArgs := []string{"sign", "--tx-path", path, "--chainid", chainID, keyName}
+
+	fmt.Println("Executing:")
+	printCommand("gnokey", cmdArgs)
+	fmt.Println()
+
+	cmd := exec.Command("gnokey", cmdArgs...)
+
+	passphrase, nonInteractive := passphraseFor(nil, keyName)
+	if nonInteractive {
+		cmd.Stdin = strings.NewReader(passphrase + "\n")
+	} else {
+		cmd.Stdin = os.Stdin
+	}
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+	if verbose {
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+	} else {
+		cmd.Stdout = &stdoutBuf
+		cmd.Stderr = &stderrBuf
+		if !nonInteractive {
+			fmt.Print("Password: ")
+		}
+	}
+
+	if err := cmd.Run(); err != nil {
+		if !verbose {
+			fmt.Println()
+		}
+		if !verbose && stderrBuf.Len() > 0 {
+			return utils.ParseContractError(fmt.Errorf("%s", stderrBuf.String()))
+		}
+		return err
+	}
+	if !verbose {
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// BroadcastTxFile submits a signed tx file at path to remote and returns
+// gnokey's raw output.
+func BroadcastTxFile(path, remote string, verbose bool) (string, error) {
+	cmdArgs := []string{"broadcast", "--remote", remote, path}
+
+	if verbose {
+		fmt.Println("Executing:")
+		printCommand("gnokey", cmdArgs)
+		fmt.Println()
+	}
+
+	cmd := exec.Command("gnokey", cmdArgs...)
+	output, err := cmd.CombinedOutput()
+	if verbose {
+		fmt.Println(string(output))
+	}
+	if err != nil {
+		return "", utils.ParseContractError(fmt.Errorf("broadcast failed: %w", err))
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,147 @@
+package gnokey
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/metrics"
+	"goo-cli/internal/utils"
+)
+
+// RPCExecutor queries the Gno RPC endpoint's abci_query directly instead
+// of shelling out to the gnokey CLI for every read, avoiding the
+// fork-per-query overhead and the requirement that gnokey be installed
+// just to read state. It embeds a *TxExecutor and defers every
+// transaction-signing call to it: building and broadcasting a signed
+// MsgCall natively requires the same amino tx encoding gnokey embeds,
+// which this package doesn't vendor, so CallFunction stays the shell-out
+// fallback described on its embedded TxExecutor.
+type RPCExecutor struct {
+	*TxExecutor
+	httpClient *http.Client
+}
+
+// NewRPCExecutor builds an RPCExecutor from cfg. Its query path talks to
+// cfg.Remote directly; CallFunction is inherited from the embedded
+// TxExecutor and still shells out to gnokey.
+func NewRPCExecutor(cfg *config.Config, verbose bool) *RPCExecutor {
+	return &RPCExecutor{
+		TxExecutor: NewExecutor(cfg, verbose),
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// abciQueryResponse is the subset of a Tendermint/CometBFT-style
+// JSON-RPC abci_query response this package needs.
+type abciQueryResponse struct {
+	Result struct {
+		Response struct {
+			Code   int    `json:"code"`
+			Log    string `json:"log"`
+			Value  string `json:"value"`
+			Height string `json:"height"`
+		} `json:"response"`
+	} `json:"result"`
+	Error *struct {
+		Message string `json:"message"`
+		Data    string `json:"data"`
+	} `json:"error"`
+}
+
+// abciQuery runs an abci_query RPC call against e.Remote for path/data
+// and returns the decoded response value as raw text - the same
+// human-readable gno value representation gnokey prints after "data:" -
+// along with the block height it was read at.
+func (e *RPCExecutor) abciQuery(path string, data []byte) (value string, height int64, err error) {
+	u, err := url.Parse(e.Remote)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid remote %q: %w", e.Remote, err)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/abci_query"
+
+	q := u.Query()
+	q.Set("path", fmt.Sprintf("%q", path))
+	q.Set("data", fmt.Sprintf("%q", "0x"+hex.EncodeToString(data)))
+	u.RawQuery = q.Encode()
+
+	resp, err := e.httpClient.Get(u.String())
+	if err != nil {
+		return "", 0, fmt.Errorf("abci_query request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read abci_query response: %w", err)
+	}
+
+	var parsed abciQueryResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", 0, fmt.Errorf("failed to parse abci_query response: %w", err)
+	}
+	if parsed.Error != nil {
+		return "", 0, fmt.Errorf("abci_query error: %s", parsed.Error.Message)
+	}
+	if parsed.Result.Response.Code != 0 {
+		return "", 0, fmt.Errorf("abci_query failed: %s", parsed.Result.Response.Log)
+	}
+
+	valueBytes, err := base64.StdEncoding.DecodeString(parsed.Result.Response.Value)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to decode abci_query value: %w", err)
+	}
+
+	fmt.Sscanf(parsed.Result.Response.Height, "%d", &height)
+	return string(valueBytes), height, nil
+}
+
+// QueryFunction runs funcName's vm/qeval query natively over RPC,
+// producing output identical in shape to gnokey's "query vm/qeval". If
+// the RPC call fails for any reason (unreachable endpoint, unexpected
+// response shape), it falls back to the embedded TxExecutor's gnokey
+// CLI-based QueryFunction so a flaky or misconfigured RPC connection
+// doesn't regress existing behavior.
+func (e *RPCExecutor) QueryFunction(funcName string, args []string) (string, error) {
+	start := time.Now()
+
+	queryPath := fmt.Sprintf("%s.%s(", e.RealmPath, funcName)
+	if len(args) > 0 {
+		queryPath += strings.Join(formatArgs(args), ",")
+	}
+	queryPath += ")"
+
+	value, height, err := e.abciQuery("vm/qeval", []byte(queryPath))
+	if err != nil {
+		if e.Verbose {
+			fmt.Printf("native abci_query failed (%v), falling back to gnokey CLI\n", err)
+		}
+		return e.TxExecutor.QueryFunction(funcName, args)
+	}
+
+	metrics.ObserveGnokeyCall(funcName, "query", time.Since(start))
+	return fmt.Sprintf("height: %d\ndata: %s\n", height, value), nil
+}
+
+// QueryInt64 queries funcName natively and decodes the result as an
+// int64, mirroring TxExecutor.QueryInt64.
+func (e *RPCExecutor) QueryInt64(funcName string) (int64, error) {
+	result, err := e.QueryFunction(funcName, []string{})
+	if err != nil {
+		return 0, err
+	}
+
+	value, err := gnoabi.DecodeInt64(result)
+	if err != nil {
+		return 0, utils.ParseContractError(fmt.Errorf("failed to decode int64 from query result: %w", err))
+	}
+	return value, nil
+}
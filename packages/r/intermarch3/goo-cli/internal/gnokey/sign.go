@@ -0,0 +1,115 @@
+package gnokey
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SignBytes signs an arbitrary payload with e.KeyName, independent of any
+// on-chain transaction, for binding off-chain evidence (e.g. a file
+// referenced from a request's AncillaryData) to a gnokey identity.
+func (e *TxExecutor) SignBytes(payload []byte) (sigHex, pubkeyHex string, err error) {
+	cmdArgs := []string{
+		"sign",
+		"--keyname", e.KeyName,
+		"--data-string", string(payload),
+	}
+
+	if e.Verbose {
+		fmt.Println("Executing:")
+		printCommand("gnokey", cmdArgs)
+		fmt.Println()
+	}
+
+	output, err := e.runSigningCommand(cmdArgs)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to sign payload: %w", err)
+	}
+
+	sigHex, pubkeyHex, err = parseSignOutput(output)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse gnokey sign output: %w", err)
+	}
+	return sigHex, pubkeyHex, nil
+}
+
+// VerifyBytes checks sigHex against payload for the account at address,
+// shelling out to `gnokey verify` and independently checking both its
+// valid/invalid verdict and the signer address it recovered - see
+// parseVerifyOutput. A failure running gnokey itself (binary missing, bad
+// args, transient I/O error) is returned as an error rather than a false
+// result, so callers can tell "never verified" apart from "verified and
+// found invalid".
+func (e *TxExecutor) VerifyBytes(payload []byte, sigHex, address string) (bool, error) {
+	cmdArgs := []string{
+		"verify",
+		address,
+		sigHex,
+		"--data-string", string(payload),
+	}
+
+	if e.Verbose {
+		fmt.Println("Executing:")
+		printCommand("gnokey", cmdArgs)
+		fmt.Println()
+	}
+
+	output, err := e.runSigningCommand(cmdArgs)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify signature: %w", err)
+	}
+
+	return parseVerifyOutput(output, address)
+}
+
+// parseVerifyOutput decides whether output reports a valid signature by
+// address. It requires an exact "Valid signature!"/"Invalid signature!"
+// status line rather than a loose substring match - strings.Contains(output,
+// "valid") also matches "invalid", which would report a forged or corrupted
+// signature as valid. It additionally recovers the signer gnokey verify
+// printed from the signature and compares it against address itself, rather
+// than trusting gnokey's own verdict to have checked the right account.
+func parseVerifyOutput(output, address string) (bool, error) {
+	var status, signer string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.EqualFold(line, "Valid signature!"):
+			status = "valid"
+		case strings.EqualFold(line, "Invalid signature!"):
+			status = "invalid"
+		case strings.HasPrefix(line, "Signer:"):
+			signer = strings.TrimSpace(strings.TrimPrefix(line, "Signer:"))
+		}
+	}
+
+	if status == "" {
+		return false, fmt.Errorf("could not find a recognizable verification status in gnokey verify output:\n%s", output)
+	}
+	if status == "invalid" {
+		return false, nil
+	}
+	if signer != "" && signer != address {
+		return false, fmt.Errorf("gnokey verify reported a valid signature, but by %s, not %s", signer, address)
+	}
+	return true, nil
+}
+
+// parseSignOutput extracts the "Signature:" and "PubKey:" hex values from
+// gnokey sign's textual output.
+func parseSignOutput(output string) (sigHex, pubkeyHex string, err error) {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "Signature:"):
+			sigHex = strings.TrimSpace(strings.TrimPrefix(line, "Signature:"))
+		case strings.HasPrefix(line, "PubKey:"):
+			pubkeyHex = strings.TrimSpace(strings.TrimPrefix(line, "PubKey:"))
+		}
+	}
+
+	if sigHex == "" {
+		return "", "", fmt.Errorf("no \"Signature:\" line found in output:\n%s", output)
+	}
+	return sigHex, pubkeyHex, nil
+}
@@ -0,0 +1,43 @@
+package gnokey
+
+import "testing"
+
+func TestParseVerifyOutputRejectsInvalidSignature(t *testing.T) {
+	output := "Invalid signature!\n"
+	valid, err := parseVerifyOutput(output, "g1abcdefabcdefabcdefabcdefabcdefabcdefabc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if valid {
+		t.Fatal("parseVerifyOutput reported an \"Invalid signature!\" output as valid")
+	}
+}
+
+func TestParseVerifyOutputAcceptsValidSignature(t *testing.T) {
+	output := "Valid signature!\nSigner: g1abcdefabcdefabcdefabcdefabcdefabcdefabc\n"
+	valid, err := parseVerifyOutput(output, "g1abcdefabcdefabcdefabcdefabcdefabcdefabc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !valid {
+		t.Fatal("parseVerifyOutput reported a \"Valid signature!\" output as invalid")
+	}
+}
+
+func TestParseVerifyOutputRejectsSignerMismatch(t *testing.T) {
+	output := "Valid signature!\nSigner: g1other000000000000000000000000000000000\n"
+	valid, err := parseVerifyOutput(output, "g1abcdefabcdefabcdefabcdefabcdefabcdefabc")
+	if err == nil {
+		t.Fatal("expected an error when the recovered signer doesn't match the requested address")
+	}
+	if valid {
+		t.Fatal("parseVerifyOutput reported a signer mismatch as valid")
+	}
+}
+
+func TestParseVerifyOutputRejectsUnrecognizedOutput(t *testing.T) {
+	output := "some unexpected gnokey output\n"
+	if _, err := parseVerifyOutput(output, "g1abcdefabcdefabcdefabcdefabcdefabcdefabc"); err == nil {
+		t.Fatal("expected an error when no recognizable status line is found")
+	}
+}
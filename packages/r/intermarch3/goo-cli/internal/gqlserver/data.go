@@ -0,0 +1,201 @@
+package gqlserver
+
+import (
+	"fmt"
+
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/gnokey"
+	"goo-cli/pkg/types"
+)
+
+// source bundles the pieces resolvers need to read chain state: an
+// executor to reach gnokey and a TTL cache to avoid hammering it.
+type source struct {
+	executor *gnokey.TxExecutor
+	cache    *ttlCache
+}
+
+// query runs a cached gnokey query, re-querying the RPC endpoint only once
+// the cached value has expired.
+func (s *source) query(funcName string, args []string) (string, error) {
+	key := cacheKey(funcName, args)
+	if cached, ok := s.cache.get(key); ok {
+		return cached, nil
+	}
+
+	result, err := s.executor.QueryFunction(funcName, args)
+	if err != nil {
+		return "", err
+	}
+
+	s.cache.set(key, result)
+	return result, nil
+}
+
+func (s *source) getRequest(id string) (*types.Request, error) {
+	result, err := s.query("GetRequest", []string{id})
+	if err != nil {
+		return nil, err
+	}
+	return gnoabi.DecodeRequest(result)
+}
+
+func (s *source) getDispute(id string) (*types.Dispute, error) {
+	result, err := s.query("GetDispute", []string{id})
+	if err != nil {
+		return nil, err
+	}
+	return gnoabi.DecodeDispute(result)
+}
+
+// queryUncached runs funcName against the chain directly instead of
+// answering from the TTL cache, refreshing the cache entry for other callers
+// along the way. Used where staleness up to the cache's TTL defeats the
+// point of the read, like subscription polling.
+func (s *source) queryUncached(funcName string, args []string) (string, error) {
+	result, err := s.executor.QueryFunction(funcName, args)
+	if err != nil {
+		return "", err
+	}
+	s.cache.set(cacheKey(funcName, args), result)
+	return result, nil
+}
+
+func (s *source) getRequestUncached(id string) (*types.Request, error) {
+	result, err := s.queryUncached("GetRequest", []string{id})
+	if err != nil {
+		return nil, err
+	}
+	return gnoabi.DecodeRequest(result)
+}
+
+func (s *source) getDisputeUncached(id string) (*types.Dispute, error) {
+	result, err := s.queryUncached("GetDispute", []string{id})
+	if err != nil {
+		return nil, err
+	}
+	return gnoabi.DecodeDispute(result)
+}
+
+func (s *source) requestIDs(state string) ([]string, error) {
+	if state == "" {
+		result, err := s.query("GetRequestsIds", []string{})
+		if err != nil {
+			return nil, err
+		}
+		return gnoabi.DecodeStringSlice(result)
+	}
+
+	result, err := s.query("GetRequestsIdsWithState", []string{state})
+	if err != nil {
+		return nil, err
+	}
+	return gnoabi.DecodeStringSlice(result)
+}
+
+// listRequests fetches every request matching state (or all states when
+// state is empty) and applies the remaining queryRequests filters.
+func (s *source) listRequests(state, creator string, yesno *bool, deadlineAfter, deadlineBefore int64) ([]*types.Request, error) {
+	ids, err := s.requestIDs(state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list request ids: %w", err)
+	}
+
+	var requests []*types.Request
+	for _, id := range ids {
+		req, err := s.getRequest(id)
+		if err != nil {
+			continue
+		}
+		if creator != "" && req.Requester != creator {
+			continue
+		}
+		if yesno != nil && req.YesNoQuestion != *yesno {
+			continue
+		}
+		if deadlineAfter > 0 && req.Deadline.Unix() < deadlineAfter {
+			continue
+		}
+		if deadlineBefore > 0 && req.Deadline.Unix() > deadlineBefore {
+			continue
+		}
+		requests = append(requests, req)
+	}
+
+	return requests, nil
+}
+
+// listDisputes fetches the dispute for every request currently in the
+// Disputed or Resolved state and filters by status ("active"/"resolved").
+func (s *source) listDisputes(status string) ([]*types.Dispute, error) {
+	var ids []string
+	for _, state := range []string{"Disputed", "Resolved"} {
+		stateIDs, err := s.requestIDs(state)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s request ids: %w", state, err)
+		}
+		ids = append(ids, stateIDs...)
+	}
+
+	var disputes []*types.Dispute
+	for _, id := range ids {
+		dispute, err := s.getDispute(id)
+		if err != nil {
+			// Not every disputed/resolved request necessarily has dispute
+			// data (e.g. resolved without ever being disputed); skip it.
+			continue
+		}
+		switch status {
+		case "active":
+			if dispute.Resolved {
+				continue
+			}
+		case "resolved":
+			if !dispute.Resolved {
+				continue
+			}
+		}
+		disputes = append(disputes, dispute)
+	}
+
+	return disputes, nil
+}
+
+// voterVote is a single vote cast by a voter, carrying the request ID it
+// belongs to (types.Vote on its own doesn't, since it's always read off a
+// specific dispute).
+type voterVote struct {
+	RequestID string
+	Vote      types.Vote
+}
+
+// voterHistory scans every dispute for votes cast by address. There's no
+// dedicated "votes by voter" query exposed by the realm, so this pays for
+// an O(disputes) scan; fine for the TTL-cached, infrequent reads a GraphQL
+// dashboard makes.
+func (s *source) voterHistory(address string) ([]voterVote, error) {
+	disputes, err := s.listDisputes("")
+	if err != nil {
+		return nil, err
+	}
+
+	var votes []voterVote
+	for _, dispute := range disputes {
+		for _, v := range dispute.Votes {
+			if v.Voter == address {
+				votes = append(votes, voterVote{RequestID: dispute.RequestID, Vote: v})
+			}
+		}
+	}
+
+	return votes, nil
+}
+
+// voteTokenBalance queries BalanceOfVoteToken for a specific address.
+func (s *source) voteTokenBalance(address string) (int64, error) {
+	result, err := s.query("BalanceOfVoteToken", []string{address})
+	if err != nil {
+		return 0, err
+	}
+	return gnoabi.DecodeInt64(result)
+}
@@ -0,0 +1,419 @@
+package gqlserver
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+	"goo-cli/pkg/types"
+)
+
+var voteType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Vote",
+	Fields: graphql.Fields{
+		"voter":    &graphql.Field{Type: graphql.String},
+		"hash":     &graphql.Field{Type: graphql.String},
+		"revealed": &graphql.Field{Type: graphql.Boolean},
+		"value":    &graphql.Field{Type: graphql.String},
+	},
+})
+
+var requestType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Request",
+	Fields: graphql.Fields{
+		"id":              &graphql.Field{Type: graphql.String},
+		"requester":       &graphql.Field{Type: graphql.String},
+		"createdAt":       &graphql.Field{Type: graphql.String},
+		"ancillaryData":   &graphql.Field{Type: graphql.String},
+		"yesNoQuestion":   &graphql.Field{Type: graphql.Boolean},
+		"proposedValue":   &graphql.Field{Type: graphql.String},
+		"proposer":        &graphql.Field{Type: graphql.String},
+		"proposerBond":    &graphql.Field{Type: graphql.String},
+		"disputer":        &graphql.Field{Type: graphql.String},
+		"disputerBond":    &graphql.Field{Type: graphql.String},
+		"resolutionTime":  &graphql.Field{Type: graphql.String},
+		"winningValue":    &graphql.Field{Type: graphql.String},
+		"state":           &graphql.Field{Type: graphql.String},
+		"deadline":        &graphql.Field{Type: graphql.String},
+		"requesterReward": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var disputeType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Dispute",
+	Fields: graphql.Fields{
+		"requestId":          &graphql.Field{Type: graphql.String},
+		"disputer":           &graphql.Field{Type: graphql.String},
+		"disputeInitiatedAt": &graphql.Field{Type: graphql.String},
+		"voteEndTime":        &graphql.Field{Type: graphql.String},
+		"revealEndTime":      &graphql.Field{Type: graphql.String},
+		"votes":              &graphql.Field{Type: graphql.NewList(voteType)},
+		"totalVotes":         &graphql.Field{Type: graphql.String},
+		"votesFor":           &graphql.Field{Type: graphql.String},
+		"votesAgainst":       &graphql.Field{Type: graphql.String},
+		"winningValue":       &graphql.Field{Type: graphql.String},
+		"resolved":           &graphql.Field{Type: graphql.Boolean},
+	},
+})
+
+var statusType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Status",
+	Fields: graphql.Fields{
+		"chainId":   &graphql.Field{Type: graphql.String},
+		"remote":    &graphql.Field{Type: graphql.String},
+		"realmPath": &graphql.Field{Type: graphql.String},
+	},
+})
+
+// proposalType is the subset of a Request's fields describing its
+// proposed value, exposed separately for clients that only care about
+// proposal activity rather than the full request lifecycle.
+var proposalType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Proposal",
+	Fields: graphql.Fields{
+		"requestId": &graphql.Field{Type: graphql.String},
+		"proposer":  &graphql.Field{Type: graphql.String},
+		"value":     &graphql.Field{Type: graphql.String},
+		"bond":      &graphql.Field{Type: graphql.String},
+	},
+})
+
+// voterVoteType is a Vote annotated with the request it was cast on, for
+// getVoterHistory results.
+var voterVoteType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "VoterVote",
+	Fields: graphql.Fields{
+		"requestId": &graphql.Field{Type: graphql.String},
+		"hash":      &graphql.Field{Type: graphql.String},
+		"revealed":  &graphql.Field{Type: graphql.Boolean},
+		"value":     &graphql.Field{Type: graphql.String},
+	},
+})
+
+var voteTokenType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "VoteToken",
+	Fields: graphql.Fields{
+		"owner":   &graphql.Field{Type: graphql.String},
+		"balance": &graphql.Field{Type: graphql.String},
+	},
+})
+
+func requestToMap(r *types.Request) map[string]interface{} {
+	return map[string]interface{}{
+		"id":              r.ID,
+		"requester":       r.Requester,
+		"createdAt":       utils.FormatTimeRFC3339(r.CreatedAt),
+		"ancillaryData":   r.AncillaryData,
+		"yesNoQuestion":   r.YesNoQuestion,
+		"proposedValue":   fmt.Sprintf("%d", r.ProposedValue),
+		"proposer":        r.Proposer,
+		"proposerBond":    fmt.Sprintf("%d", r.ProposerBond),
+		"disputer":        r.Disputer,
+		"disputerBond":    fmt.Sprintf("%d", r.DisputerBond),
+		"resolutionTime":  utils.FormatTimeRFC3339(r.ResolutionTime),
+		"winningValue":    fmt.Sprintf("%d", r.WinningValue),
+		"state":           r.State.String(),
+		"deadline":        utils.FormatTimeRFC3339(r.Deadline),
+		"requesterReward": fmt.Sprintf("%d", r.RequesterReward),
+	}
+}
+
+func disputeToMap(d *types.Dispute) map[string]interface{} {
+	votes := make([]map[string]interface{}, len(d.Votes))
+	for i, v := range d.Votes {
+		votes[i] = map[string]interface{}{
+			"voter":    v.Voter,
+			"hash":     v.Hash,
+			"revealed": v.Revealed,
+			"value":    fmt.Sprintf("%d", v.Value),
+		}
+	}
+
+	return map[string]interface{}{
+		"requestId":          d.RequestID,
+		"disputer":           d.Disputer,
+		"disputeInitiatedAt": utils.FormatTimeRFC3339(d.DisputeInitiatedAt),
+		"voteEndTime":        utils.FormatTimeRFC3339(d.VoteEndTime),
+		"revealEndTime":      utils.FormatTimeRFC3339(d.RevealEndTime),
+		"votes":              votes,
+		"totalVotes":         fmt.Sprintf("%d", d.TotalVotes),
+		"votesFor":           fmt.Sprintf("%d", d.VotesFor),
+		"votesAgainst":       fmt.Sprintf("%d", d.VotesAgainst),
+		"winningValue":       fmt.Sprintf("%d", d.WinningValue),
+		"resolved":           d.Resolved,
+	}
+}
+
+func proposalToMap(r *types.Request) map[string]interface{} {
+	return map[string]interface{}{
+		"requestId": r.ID,
+		"proposer":  r.Proposer,
+		"value":     fmt.Sprintf("%d", r.ProposedValue),
+		"bond":      fmt.Sprintf("%d", r.ProposerBond),
+	}
+}
+
+func voterVoteToMap(v voterVote) map[string]interface{} {
+	return map[string]interface{}{
+		"requestId": v.RequestID,
+		"hash":      v.Vote.Hash,
+		"revealed":  v.Vote.Revealed,
+		"value":     fmt.Sprintf("%d", v.Vote.Value),
+	}
+}
+
+// buildSchema assembles the query (and, when allowMutations is set,
+// mutation) root for src.
+func buildSchema(cfg *config.Config, src *source, executor *gnokey.TxExecutor, allowMutations bool) (graphql.Schema, error) {
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"getRequest": &graphql.Field{
+				Type: requestType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					req, err := src.getRequest(p.Args["id"].(string))
+					if err != nil {
+						return nil, err
+					}
+					return requestToMap(req), nil
+				},
+			},
+			"queryRequests": &graphql.Field{
+				Type: graphql.NewList(requestType),
+				Args: graphql.FieldConfigArgument{
+					"state":          &graphql.ArgumentConfig{Type: graphql.String},
+					"creator":        &graphql.ArgumentConfig{Type: graphql.String},
+					"yesno":          &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"deadlineAfter":  &graphql.ArgumentConfig{Type: graphql.String},
+					"deadlineBefore": &graphql.ArgumentConfig{Type: graphql.String},
+					"first":          &graphql.ArgumentConfig{Type: graphql.Int},
+					"after":          &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					state, _ := p.Args["state"].(string)
+					creator, _ := p.Args["creator"].(string)
+
+					var yesno *bool
+					if v, ok := p.Args["yesno"].(bool); ok {
+						yesno = &v
+					}
+
+					deadlineAfter, err := parseDeadlineArg(p.Args["deadlineAfter"])
+					if err != nil {
+						return nil, err
+					}
+					deadlineBefore, err := parseDeadlineArg(p.Args["deadlineBefore"])
+					if err != nil {
+						return nil, err
+					}
+
+					requests, err := src.listRequests(state, creator, yesno, deadlineAfter, deadlineBefore)
+					if err != nil {
+						return nil, err
+					}
+
+					requests = paginateRequests(requests, p.Args["after"], p.Args["first"])
+
+					results := make([]map[string]interface{}, len(requests))
+					for i, req := range requests {
+						results[i] = requestToMap(req)
+					}
+					return results, nil
+				},
+			},
+			"getDispute": &graphql.Field{
+				Type: disputeType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					dispute, err := src.getDispute(p.Args["id"].(string))
+					if err != nil {
+						return nil, err
+					}
+					return disputeToMap(dispute), nil
+				},
+			},
+			"queryDisputes": &graphql.Field{
+				Type: graphql.NewList(disputeType),
+				Args: graphql.FieldConfigArgument{
+					"status": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					status, _ := p.Args["status"].(string)
+					disputes, err := src.listDisputes(status)
+					if err != nil {
+						return nil, err
+					}
+					results := make([]map[string]interface{}, len(disputes))
+					for i, d := range disputes {
+						results[i] = disputeToMap(d)
+					}
+					return results, nil
+				},
+			},
+			"getStatus": &graphql.Field{
+				Type: statusType,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return map[string]interface{}{
+						"chainId":   cfg.ChainID,
+						"remote":    cfg.Remote,
+						"realmPath": cfg.RealmPath,
+					}, nil
+				},
+			},
+			"getProposal": &graphql.Field{
+				Type: proposalType,
+				Args: graphql.FieldConfigArgument{
+					"requestId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					req, err := src.getRequest(p.Args["requestId"].(string))
+					if err != nil {
+						return nil, err
+					}
+					return proposalToMap(req), nil
+				},
+			},
+			"getVoterHistory": &graphql.Field{
+				Type: graphql.NewList(voterVoteType),
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					votes, err := src.voterHistory(p.Args["address"].(string))
+					if err != nil {
+						return nil, err
+					}
+					results := make([]map[string]interface{}, len(votes))
+					for i, v := range votes {
+						results[i] = voterVoteToMap(v)
+					}
+					return results, nil
+				},
+			},
+			"getVoteTokenBalance": &graphql.Field{
+				Type: voteTokenType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					address := p.Args["address"].(string)
+					balance, err := src.voteTokenBalance(address)
+					if err != nil {
+						return nil, err
+					}
+					return map[string]interface{}{
+						"owner":   address,
+						"balance": fmt.Sprintf("%d", balance),
+					}, nil
+				},
+			},
+		},
+	})
+
+	schemaConfig := graphql.SchemaConfig{Query: queryType}
+
+	if allowMutations {
+		schemaConfig.Mutation = buildMutationType(executor)
+	}
+
+	return graphql.NewSchema(schemaConfig)
+}
+
+// buildMutationType wraps RequestData/DisputeData as GraphQL mutations.
+// These submit real signed transactions via executor (gnokey prompts for
+// the signing key's password on its own stdin), so they are only wired in
+// when the operator opts in with --allow-mutations.
+func buildMutationType(executor *gnokey.TxExecutor) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"requestData": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"question":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"yesNoQuestion": &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"deadline":      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"reward":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					deadlineTime, err := utils.ParseDeadline(p.Args["deadline"].(string))
+					if err != nil {
+						return nil, err
+					}
+					yesno, _ := p.Args["yesNoQuestion"].(bool)
+
+					funcArgs := []string{
+						p.Args["question"].(string),
+						utils.FormatBool(yesno),
+						fmt.Sprintf("%d", deadlineTime.Unix()),
+					}
+					sendAmount := fmt.Sprintf("%dugnot", p.Args["reward"].(int))
+					if err := executor.CallFunction("RequestData", funcArgs, sendAmount); err != nil {
+						return nil, err
+					}
+					return "submitted", nil
+				},
+			},
+			"disputeData": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"requestId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					requestID := p.Args["requestId"].(string)
+
+					bond, err := executor.QueryInt64("GetBond")
+					if err != nil {
+						return nil, fmt.Errorf("failed to query bond amount: %w", err)
+					}
+					sendAmount := fmt.Sprintf("%dugnot", bond)
+					if err := executor.CallFunction("DisputeData", []string{requestID}, sendAmount); err != nil {
+						return nil, err
+					}
+					return "submitted", nil
+				},
+			},
+		},
+	})
+}
+
+// parseDeadlineArg parses an optional RFC3339 GraphQL argument into a unix
+// timestamp, returning 0 when arg is nil/empty (meaning "no bound").
+func parseDeadlineArg(arg interface{}) (int64, error) {
+	s, ok := arg.(string)
+	if !ok || s == "" {
+		return 0, nil
+	}
+	t, err := utils.ParseDeadline(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid deadline argument %q: %w", s, err)
+	}
+	return t.Unix(), nil
+}
+
+// paginateRequests applies the after/first cursor: after is the last
+// request ID already seen by the caller, first caps the page size.
+func paginateRequests(requests []*types.Request, after, first interface{}) []*types.Request {
+	if afterID, ok := after.(string); ok && afterID != "" {
+		for i, req := range requests {
+			if req.ID == afterID {
+				requests = requests[i+1:]
+				break
+			}
+		}
+	}
+
+	if firstN, ok := first.(int); ok && firstN >= 0 && firstN < len(requests) {
+		requests = requests[:firstN]
+	}
+
+	return requests
+}
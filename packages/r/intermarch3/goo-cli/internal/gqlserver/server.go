@@ -0,0 +1,99 @@
+// Package gqlserver implements the GraphQL API behind `goo serve`: a
+// read-only query schema over oracle requests/disputes backed by a TTL
+// cache in front of gnokey, optional mutation resolvers wrapping
+// RequestData/DisputeData, and a websocket endpoint that polls the chain
+// and pushes changes to subscribers.
+package gqlserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/handler"
+
+	"goo-cli/internal/config"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/utils"
+)
+
+// Server is the long-running process behind `goo serve`.
+type Server struct {
+	cfg            *config.Config
+	src            *source
+	schema         graphql.Schema
+	pollInterval   time.Duration
+	playground     bool
+	allowMutations bool
+}
+
+// NewServer builds a Server over executor using cfg's serve_* settings.
+func NewServer(cfg *config.Config, executor *gnokey.TxExecutor, allowMutations bool) (*Server, error) {
+	cacheTTL := time.Duration(cfg.ServeCacheTTLSeconds) * time.Second
+	src := &source{executor: executor, cache: newTTLCache(cacheTTL)}
+
+	schema, err := buildSchema(cfg, src, executor, allowMutations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		cfg:            cfg,
+		src:            src,
+		schema:         schema,
+		pollInterval:   time.Duration(cfg.ServePollIntervalSeconds) * time.Second,
+		playground:     cfg.ServePlayground,
+		allowMutations: allowMutations,
+	}, nil
+}
+
+// Run starts the HTTP server and blocks until ctx is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	gqlHandler := handler.New(&handler.Config{
+		Schema:     &s.schema,
+		Pretty:     true,
+		GraphiQL:   false,
+		Playground: s.playground,
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", gqlHandler)
+	if s.playground {
+		mux.Handle("/", gqlHandler)
+		mux.Handle("/playground", gqlHandler)
+	}
+	mux.HandleFunc("/subscriptions", s.handleSubscriptions)
+
+	httpServer := &http.Server{
+		Addr:    s.cfg.ServeListenAddr,
+		Handler: mux,
+	}
+
+	utils.PrintInfo("Listening on " + s.cfg.ServeListenAddr)
+	utils.PrintInfo("GraphQL endpoint: POST /graphql")
+	if s.playground {
+		utils.PrintInfo("Playground: GET / (also served at /playground)")
+	}
+	utils.PrintInfo("Subscriptions (websocket): /subscriptions")
+	if s.allowMutations {
+		utils.PrintWarning("Mutations are enabled - requestData/disputeData will sign and broadcast transactions")
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return httpServer.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		if err == http.ErrServerClosed {
+			return nil
+		}
+		return err
+	}
+}
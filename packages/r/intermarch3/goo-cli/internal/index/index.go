@@ -0,0 +1,397 @@
+// Package index maintains a local, file-backed cache of types.Request and
+// types.Dispute records so read-heavy commands like 'query list' and 'query
+// dispute' can answer filters offline instead of paying an RPC per request.
+// It follows the same convention internal/vault uses for local persistent
+// state - plain JSON files plus an in-memory index map, rebuilt from the
+// cached records if the index file itself goes missing or corrupt - rather
+// than a real embedded database, since nothing else in this tree uses one.
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"goo-cli/pkg/types"
+)
+
+const (
+	requestsFileName = "requests.json"
+	disputesFileName = "disputes.json"
+)
+
+// entry is one cached request plus the last-known-state bookkeeping Sync
+// needs to decide whether it's worth re-fetching.
+type entry struct {
+	Request    types.Request `json:"request"`
+	LastSynced time.Time     `json:"last_synced"`
+}
+
+// cacheFile is the on-disk encoding of the request cache. HighWaterID is
+// the highest request ID Sync has ever cached. There's no GetRequestsPage-
+// style endpoint in this tree to list only IDs past it, so every sync still
+// calls GetRequestsIds for the complete list - but an incremental sync uses
+// HighWaterID to decide which of those IDs are worth a GetRequest: see
+// Index.shouldFetch.
+type cacheFile struct {
+	HighWaterID string           `json:"high_water_id"`
+	Entries     map[string]entry `json:"entries"`
+}
+
+// disputeCacheFile is the on-disk encoding of the dispute cache, keyed by
+// request ID the same way cacheFile is.
+type disputeCacheFile struct {
+	Entries map[string]types.Dispute `json:"entries"`
+}
+
+// Index is a local cache of requests (and their disputes, once disputed)
+// rooted at Dir. Reads always come from the in-memory maps loaded at Open
+// time; Sync is the only thing that talks to the chain.
+//
+// byState/byRequester/byProposer/byDeadline are secondary indexes over
+// requests.Entries that let List and UpcomingDeadlines answer without a
+// linear scan. They're derived, not persisted - rebuildSecondaryIndexes
+// reconstructs them from requests.Entries once at Open, and Put/PutDispute
+// keep them in sync incrementally from then on.
+type Index struct {
+	Dir      string
+	requests cacheFile
+	disputes disputeCacheFile
+
+	byState     map[string]map[string]bool
+	byRequester map[string]map[string]bool
+	byProposer  map[string]map[string]bool
+	byDeadline  []string // request IDs in ascending Deadline order
+}
+
+// DefaultDir returns ~/.goo/index, the root the local request/dispute cache
+// is stored under, creating it if necessary.
+func DefaultDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	dir := filepath.Join(homeDir, ".goo", "index")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create index directory: %w", err)
+	}
+	return dir, nil
+}
+
+// Open loads the cache at dir into memory, starting empty if this is the
+// first time the index has been synced there.
+func Open(dir string) (*Index, error) {
+	idx := &Index{Dir: dir}
+
+	if data, err := os.ReadFile(filepath.Join(dir, requestsFileName)); err == nil {
+		if err := json.Unmarshal(data, &idx.requests); err != nil {
+			return nil, fmt.Errorf("failed to parse cached requests (run 'goo index sync' to rebuild): %w", err)
+		}
+	}
+	if idx.requests.Entries == nil {
+		idx.requests.Entries = map[string]entry{}
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, disputesFileName)); err == nil {
+		if err := json.Unmarshal(data, &idx.disputes); err != nil {
+			return nil, fmt.Errorf("failed to parse cached disputes (run 'goo index sync' to rebuild): %w", err)
+		}
+	}
+	if idx.disputes.Entries == nil {
+		idx.disputes.Entries = map[string]types.Dispute{}
+	}
+
+	idx.rebuildSecondaryIndexes()
+
+	return idx, nil
+}
+
+// rebuildSecondaryIndexes reconstructs byState/byRequester/byProposer/
+// byDeadline from requests.Entries, the same rebuild-from-source-of-truth
+// approach vault.rebuildIndex uses when its own index can't be trusted -
+// here that's simply "every time the process starts", since the secondary
+// indexes are never written to disk.
+func (idx *Index) rebuildSecondaryIndexes() {
+	idx.byState = map[string]map[string]bool{}
+	idx.byRequester = map[string]map[string]bool{}
+	idx.byProposer = map[string]map[string]bool{}
+	idx.byDeadline = nil
+
+	ids := make([]string, 0, len(idx.requests.Entries))
+	for id := range idx.requests.Entries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		idx.indexAdd(idx.requests.Entries[id].Request)
+	}
+}
+
+// indexAdd adds req's ID to every secondary index. requests.Entries[req.ID]
+// must already hold req by the time this is called, since byDeadline's
+// insertion point is found by looking up other IDs' Deadline there.
+func (idx *Index) indexAdd(req types.Request) {
+	addToSet(idx.byState, req.State.String(), req.ID)
+	if req.Requester != "" {
+		addToSet(idx.byRequester, req.Requester, req.ID)
+	}
+	if req.Proposer != "" {
+		addToSet(idx.byProposer, req.Proposer, req.ID)
+	}
+
+	i := sort.Search(len(idx.byDeadline), func(i int) bool {
+		return !idx.requests.Entries[idx.byDeadline[i]].Request.Deadline.Before(req.Deadline)
+	})
+	idx.byDeadline = append(idx.byDeadline, "")
+	copy(idx.byDeadline[i+1:], idx.byDeadline[i:])
+	idx.byDeadline[i] = req.ID
+}
+
+// indexRemove drops requestID from every secondary index, using whatever is
+// currently cached for it (its pre-update state, when called from Put
+// ahead of overwriting the entry).
+func (idx *Index) indexRemove(requestID string) {
+	old, ok := idx.requests.Entries[requestID]
+	if !ok {
+		return
+	}
+	removeFromSet(idx.byState, old.Request.State.String(), requestID)
+	removeFromSet(idx.byRequester, old.Request.Requester, requestID)
+	removeFromSet(idx.byProposer, old.Request.Proposer, requestID)
+	for i, id := range idx.byDeadline {
+		if id == requestID {
+			idx.byDeadline = append(idx.byDeadline[:i], idx.byDeadline[i+1:]...)
+			break
+		}
+	}
+}
+
+func addToSet(m map[string]map[string]bool, key, id string) {
+	if m[key] == nil {
+		m[key] = map[string]bool{}
+	}
+	m[key][id] = true
+}
+
+func removeFromSet(m map[string]map[string]bool, key, id string) {
+	set := m[key]
+	if set == nil {
+		return
+	}
+	delete(set, id)
+	if len(set) == 0 {
+		delete(m, key)
+	}
+}
+
+func (idx *Index) save() error {
+	if err := os.MkdirAll(idx.Dir, 0700); err != nil {
+		return fmt.Errorf("failed to create index directory: %w", err)
+	}
+
+	requestsData, err := json.MarshalIndent(idx.requests, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cached requests: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(idx.Dir, requestsFileName), requestsData, 0600); err != nil {
+		return fmt.Errorf("failed to write cached requests: %w", err)
+	}
+
+	disputesData, err := json.MarshalIndent(idx.disputes, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal cached disputes: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(idx.Dir, disputesFileName), disputesData, 0600); err != nil {
+		return fmt.Errorf("failed to write cached disputes: %w", err)
+	}
+
+	return nil
+}
+
+// HighWaterID returns the last request ID Sync has fully pulled, or "" if
+// the index has never been synced.
+func (idx *Index) HighWaterID() string {
+	return idx.requests.HighWaterID
+}
+
+// Len reports how many requests are cached.
+func (idx *Index) Len() int {
+	return len(idx.requests.Entries)
+}
+
+// NeedsRefresh reports whether a cached request should be re-fetched on the
+// next sync: anything short of Resolved can still change on chain, but a
+// Resolved request's fields are final, so it's treated as immutable and
+// never re-fetched once cached.
+func (idx *Index) NeedsRefresh(requestID string) bool {
+	e, ok := idx.requests.Entries[requestID]
+	if !ok {
+		return true
+	}
+	return e.Request.State != types.StateResolved
+}
+
+// shouldFetch decides whether Sync should spend a GetRequest round trip on
+// requestID. full always says yes, ignoring the cache entirely. Otherwise:
+// a cached, Resolved entry never needs it (NeedsRefresh already says so);
+// an ID past HighWaterID is definitely new and always worth fetching; an ID
+// at or below HighWaterID that was never cached is assumed to have been
+// deliberately left out of a prior sync rather than silently missed, so
+// it's skipped rather than retried forever.
+func (idx *Index) shouldFetch(requestID string, full bool) bool {
+	if full {
+		return true
+	}
+	if !idx.NeedsRefresh(requestID) {
+		return false
+	}
+	if high := idx.requests.HighWaterID; high != "" && requestID <= high {
+		_, cached := idx.Get(requestID)
+		return cached
+	}
+	return true
+}
+
+// Put records (or overwrites) requestID's cached request, updates the
+// secondary indexes and high-water mark, and persists the cache.
+func (idx *Index) Put(req *types.Request, now time.Time) error {
+	idx.indexRemove(req.ID)
+	idx.requests.Entries[req.ID] = entry{Request: *req, LastSynced: now}
+	if req.ID > idx.requests.HighWaterID {
+		idx.requests.HighWaterID = req.ID
+	}
+	idx.indexAdd(*req)
+	return idx.save()
+}
+
+// PutDispute records (or overwrites) a request's cached dispute and
+// persists the cache.
+func (idx *Index) PutDispute(dispute *types.Dispute) error {
+	idx.disputes.Entries[dispute.RequestID] = *dispute
+	return idx.save()
+}
+
+// Get returns the cached request for requestID, if any.
+func (idx *Index) Get(requestID string) (*types.Request, bool) {
+	e, ok := idx.requests.Entries[requestID]
+	if !ok {
+		return nil, false
+	}
+	req := e.Request
+	return &req, true
+}
+
+// GetDispute returns the cached dispute for requestID, if any.
+func (idx *Index) GetDispute(requestID string) (*types.Dispute, bool) {
+	d, ok := idx.disputes.Entries[requestID]
+	if !ok {
+		return nil, false
+	}
+	return &d, true
+}
+
+// LastSynced returns when requestID's cached entry was last refreshed.
+func (idx *Index) LastSynced(requestID string) (time.Time, bool) {
+	e, ok := idx.requests.Entries[requestID]
+	if !ok {
+		return time.Time{}, false
+	}
+	return e.LastSynced, true
+}
+
+// Filter describes a 'query list'-style filter applied entirely over the
+// cached requests, with no RPC involved.
+type Filter struct {
+	State     string
+	Requester string
+	Proposer  string
+	YesNo     bool
+	Since     time.Time
+}
+
+// List returns every cached request matching filter, sorted by ID. State,
+// Requester, and Proposer narrow the candidate set via the secondary
+// indexes before anything is scanned; YesNo and Since aren't indexed (the
+// realm has no native predicate for either), so they're still checked per
+// candidate, the same way query.go's N+1-fetch path applies them client-side.
+func (idx *Index) List(filter Filter) []*types.Request {
+	var candidates map[string]bool
+	narrow := func(set map[string]bool) {
+		if candidates == nil {
+			candidates = map[string]bool{}
+			for id := range set {
+				candidates[id] = true
+			}
+			return
+		}
+		for id := range candidates {
+			if !set[id] {
+				delete(candidates, id)
+			}
+		}
+	}
+
+	if filter.State != "" {
+		narrow(idx.byState[filter.State])
+	}
+	if filter.Requester != "" {
+		narrow(idx.byRequester[filter.Requester])
+	}
+	if filter.Proposer != "" {
+		narrow(idx.byProposer[filter.Proposer])
+	}
+
+	var ids []string
+	if candidates != nil {
+		for id := range candidates {
+			ids = append(ids, id)
+		}
+	} else {
+		for id := range idx.requests.Entries {
+			ids = append(ids, id)
+		}
+	}
+
+	matched := make([]*types.Request, 0, len(ids))
+	for _, id := range ids {
+		e, ok := idx.requests.Entries[id]
+		if !ok {
+			continue
+		}
+		req := e.Request
+		if filter.YesNo && !req.YesNoQuestion {
+			continue
+		}
+		if !filter.Since.IsZero() && req.CreatedAt.Before(filter.Since) {
+			continue
+		}
+		r := req
+		matched = append(matched, &r)
+	}
+
+	sort.Slice(matched, func(i, j int) bool { return matched[i].ID < matched[j].ID })
+	return matched
+}
+
+// UpcomingDeadlines returns cached non-Resolved requests in ascending
+// Deadline order (soonest first), using the byDeadline index so callers
+// don't pay a full scan just to find what needs attention next. limit <= 0
+// means no limit.
+func (idx *Index) UpcomingDeadlines(limit int) []*types.Request {
+	result := make([]*types.Request, 0, len(idx.byDeadline))
+	for _, id := range idx.byDeadline {
+		e, ok := idx.requests.Entries[id]
+		if !ok || e.Request.State == types.StateResolved {
+			continue
+		}
+		req := e.Request
+		result = append(result, &req)
+		if limit > 0 && len(result) >= limit {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package index
+
+import (
+	"fmt"
+	"time"
+
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/gnokey"
+	"goo-cli/pkg/types"
+)
+
+// Summary reports what a Sync pulled in, for 'goo index sync' to print.
+type Summary struct {
+	Fetched  int
+	Skipped  int
+	Disputed int
+	Total    int
+}
+
+// Sync refreshes the cache against the chain through executor. There's no
+// GetRequestsPage-style endpoint in this tree to page through, so listing
+// IDs is still one unconditional GetRequestsIds call; what HighWaterID and
+// full actually control is which of those IDs get a GetRequest round trip
+// - see Index.shouldFetch for the exact rule.
+func (idx *Index) Sync(executor *gnokey.TxExecutor, full bool) (Summary, error) {
+	result, err := executor.QueryFunction("GetRequestsIds", []string{})
+	if err != nil {
+		return Summary{}, fmt.Errorf("failed to list request IDs: %w", err)
+	}
+	ids, err := gnoabi.DecodeStringSlice(result)
+	if err != nil {
+		return Summary{}, fmt.Errorf("failed to decode request IDs: %w", err)
+	}
+
+	now := time.Now()
+	var summary Summary
+	for _, id := range ids {
+		if !idx.shouldFetch(id, full) {
+			summary.Skipped++
+			continue
+		}
+
+		requestResult, err := executor.QueryFunction("GetRequest", []string{id})
+		if err != nil {
+			return summary, fmt.Errorf("failed to fetch request %s: %w", id, err)
+		}
+		req, err := gnoabi.DecodeRequest(requestResult)
+		if err != nil {
+			return summary, fmt.Errorf("failed to decode request %s: %w", id, err)
+		}
+		if err := idx.Put(req, now); err != nil {
+			return summary, fmt.Errorf("failed to cache request %s: %w", id, err)
+		}
+		summary.Fetched++
+
+		if req.State == types.StateDisputed || req.State == types.StateResolved {
+			disputeResult, err := executor.QueryFunction("GetDispute", []string{id})
+			if err != nil {
+				continue
+			}
+			dispute, err := gnoabi.DecodeDispute(disputeResult)
+			if err != nil {
+				continue
+			}
+			if err := idx.PutDispute(dispute); err != nil {
+				return summary, fmt.Errorf("failed to cache dispute %s: %w", id, err)
+			}
+			summary.Disputed++
+		}
+	}
+
+	summary.Total = idx.Len()
+	return summary, nil
+}
@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/utils"
+)
+
+// queryExecutor is the subset of gnokey.TxExecutor's methods Collector
+// needs. Declared here (rather than importing internal/gnokey directly)
+// because gnokey.TxExecutor itself calls into this package to record
+// per-call duration histograms; depending on the concrete type would
+// create an import cycle.
+type queryExecutor interface {
+	QueryInt64(funcName string) (int64, error)
+	QueryFunction(funcName string, args []string) (string, error)
+}
+
+// Collector periodically scrapes contract-derived gauges (bond, reward,
+// resolution duration, open request/dispute counts) so they show up in
+// Prometheus without a dedicated indexer.
+type Collector struct {
+	executor queryExecutor
+	interval time.Duration
+}
+
+// NewCollector creates a Collector that scrapes executor every interval.
+func NewCollector(executor queryExecutor, interval time.Duration) *Collector {
+	return &Collector{executor: executor, interval: interval}
+}
+
+// Run scrapes once immediately, then every c.interval, until ctx is done.
+func (c *Collector) Run(ctx context.Context) {
+	c.scrapeOnce()
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.scrapeOnce()
+		}
+	}
+}
+
+func (c *Collector) scrapeOnce() {
+	if bond, err := c.executor.QueryInt64("GetBond"); err == nil {
+		CurrentBondUgnot.Set(float64(bond))
+	}
+	if reward, err := c.executor.QueryInt64("GetRequesterReward"); err == nil {
+		CurrentRewardUgnot.Set(float64(reward))
+	}
+	if duration, err := c.executor.QueryInt64("GetResolutionDuration"); err == nil {
+		ResolutionDurationSeconds.Set(float64(duration))
+	}
+
+	var open int
+	for _, state := range []string{"Requested", "Proposed"} {
+		if ids, err := c.queryRequestIDs(state); err == nil {
+			open += len(ids)
+		}
+	}
+	OpenRequests.Set(float64(open))
+
+	if ids, err := c.queryRequestIDs("Disputed"); err == nil {
+		ActiveDisputes.Set(float64(len(ids)))
+	}
+}
+
+func (c *Collector) queryRequestIDs(state string) ([]string, error) {
+	result, err := c.executor.QueryFunction("GetRequestsIdsWithState", []string{state})
+	if err != nil {
+		utils.PrintWarning("metrics: failed to scrape " + state + " request ids: " + err.Error())
+		return nil, err
+	}
+	return gnoabi.DecodeStringSlice(result)
+}
@@ -0,0 +1,101 @@
+// Package metrics exposes Prometheus counters, histograms, and gauges for
+// oracle activity, modeled on the info-metric pattern used by frostfs-node:
+// a static info gauge carrying build/version/chain/realm as labels, plus
+// live counters/gauges an ops team can point Grafana at without writing a
+// custom indexer.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// Info is a constant gauge (always 1) carrying build/version/chain/realm
+	// as labels, the frostfs-node info-metric pattern.
+	Info = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "goo_info",
+		Help: "Static info about the running goo CLI, labeled with build/version/chain/realm; value is always 1.",
+	}, []string{"version", "chain_id", "realm_path"})
+
+	RequestsCreatedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "goo_requests_created_total",
+		Help: "Total number of data requests created via this CLI.",
+	})
+
+	DisputesCreatedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "goo_disputes_created_total",
+		Help: "Total number of disputes created via this CLI.",
+	})
+
+	AdminOpsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "goo_admin_ops_total",
+		Help: "Total number of admin operations executed via this CLI, labeled by operation.",
+	}, []string{"op"})
+
+	GnokeyCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goo_gnokey_call_duration_seconds",
+		Help:    "Duration of gnokey invocations, labeled by realm function and call kind (tx/query).",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"func", "kind"})
+
+	CurrentBondUgnot = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "goo_current_bond_ugnot",
+		Help: "Current bond amount required for proposals and disputes, in ugnot.",
+	})
+
+	CurrentRewardUgnot = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "goo_current_reward_ugnot",
+		Help: "Current default requester reward, in ugnot.",
+	})
+
+	ResolutionDurationSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "goo_resolution_duration_seconds",
+		Help: "Current resolution duration window, in seconds.",
+	})
+
+	OpenRequests = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "goo_open_requests",
+		Help: "Number of requests in the Requested or Proposed state.",
+	})
+
+	ActiveDisputes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "goo_active_disputes",
+		Help: "Number of disputes currently open for voting.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		Info,
+		RequestsCreatedTotal,
+		DisputesCreatedTotal,
+		AdminOpsTotal,
+		GnokeyCallDuration,
+		CurrentBondUgnot,
+		CurrentRewardUgnot,
+		ResolutionDurationSeconds,
+		OpenRequests,
+		ActiveDisputes,
+	)
+}
+
+// SetInfo publishes the static info gauge, replacing any previously
+// published label set (there is ever only one goo process per metric set).
+func SetInfo(version, chainID, realmPath string) {
+	Info.Reset()
+	Info.WithLabelValues(version, chainID, realmPath).Set(1)
+}
+
+// ObserveGnokeyCall records the duration of a single gnokey invocation,
+// instrumented inside gnokey.Executor.CallFunction and QueryFunction.
+func ObserveGnokeyCall(funcName, kind string, duration time.Duration) {
+	GnokeyCallDuration.WithLabelValues(funcName, kind).Observe(duration.Seconds())
+}
+
+// IncAdminOp records one admin operation, labeled by op (e.g.
+// "set-resolution-duration", "set-bond", "change-admin").
+func IncAdminOp(op string) {
+	AdminOpsTotal.WithLabelValues(op).Inc()
+}
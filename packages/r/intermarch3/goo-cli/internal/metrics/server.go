@@ -0,0 +1,12 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Handler returns the Prometheus exposition-format HTTP handler for /metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,125 @@
+// Package research caches and attests the AI oracle runs behind
+// `goo propose value --search`, so a proposal backed by AI research can be
+// reviewed and reproduced later instead of being a one-shot, throwaway
+// answer. Every run is saved under ~/.goo/research/<requestID>-<unixTime>.json
+// together with a SHA256 attestation hash over the exact question, provider,
+// model, and raw response, so a disputer can fetch the record a proposer
+// used and confirm it hasn't been altered.
+package research
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Record is one attested AI research run.
+type Record struct {
+	RequestID       string    `json:"request_id"`
+	Timestamp       time.Time `json:"timestamp"`
+	Question        string    `json:"question"`
+	Provider        string    `json:"provider"`
+	Model           string    `json:"model"`
+	RawResponse     string    `json:"raw_response"`
+	NormalizedValue string    `json:"normalized_value"`
+	Sources         []string  `json:"sources"`
+	AttestationHash string    `json:"attestation_hash"`
+}
+
+// Attest computes the attestation hash H(question || provider || model ||
+// rawResponse) binding a research run to the exact evidence it was based on.
+func Attest(question, provider, model, rawResponse string) string {
+	sum := sha256.Sum256([]byte(question + provider + model + rawResponse))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify recomputes rec's attestation hash and reports whether it matches
+// the hash stored in rec, i.e. whether rec is untampered.
+func Verify(rec *Record) bool {
+	return Attest(rec.Question, rec.Provider, rec.Model, rec.RawResponse) == rec.AttestationHash
+}
+
+// DefaultDir returns ~/.goo/research, creating it if necessary.
+func DefaultDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	dir := filepath.Join(homeDir, ".goo", "research")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create research directory: %w", err)
+	}
+	return dir, nil
+}
+
+// Save writes rec under its default location and returns the path written.
+func Save(rec Record) (string, error) {
+	dir, err := DefaultDir()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(rec, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal research record: %w", err)
+	}
+
+	filename := fmt.Sprintf("%s-%d.json", rec.RequestID, rec.Timestamp.Unix())
+	path := filepath.Join(dir, filename)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write research record: %w", err)
+	}
+	return path, nil
+}
+
+// Load reads a research record from an arbitrary file path.
+func Load(path string) (*Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read research file: %w", err)
+	}
+	var rec Record
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("failed to parse research file: %w", err)
+	}
+	return &rec, nil
+}
+
+// ListForRequest returns every cached research record for requestID, most
+// recent first.
+func ListForRequest(requestID string) ([]Record, error) {
+	dir, err := DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read research directory: %w", err)
+	}
+
+	prefix := requestID + "-"
+	var records []Record
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		rec, err := Load(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		records = append(records, *rec)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Timestamp.After(records[j].Timestamp)
+	})
+
+	return records, nil
+}
@@ -0,0 +1,153 @@
+package search_agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// AnthropicClient queries the Claude Messages API with the `web_search`
+// server tool enabled for browsing-backed research.
+type AnthropicClient struct {
+	apiKey     string
+	apiURL     string
+	model      string
+	httpClient *http.Client
+	verbose    bool
+}
+
+type anthropicRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	System    string             `json:"system"`
+	Messages  []anthropicMessage `json:"messages"`
+	Tools     []anthropicTool    `json:"tools,omitempty"`
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicTool struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type anthropicResponse struct {
+	Content []anthropicContentBlock `json:"content"`
+}
+
+type anthropicContentBlock struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// NewAnthropicClient creates a new Anthropic client for oracle queries.
+func NewAnthropicClient(apiKey string, verbose bool) (*AnthropicClient, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("API key cannot be empty")
+	}
+
+	return &AnthropicClient{
+		apiKey:     apiKey,
+		apiURL:     "https://api.anthropic.com/v1/messages",
+		model:      "claude-3-5-sonnet-latest",
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+		verbose:    verbose,
+	}, nil
+}
+
+// Name identifies this provider for display and consensus voting.
+func (c *AnthropicClient) Name() string {
+	return "anthropic"
+}
+
+// Model identifies the specific Claude model queried.
+func (c *AnthropicClient) Model() string {
+	return c.model
+}
+
+// Close releases the client's resources.
+func (c *AnthropicClient) Close() error {
+	return nil
+}
+
+// QueryQuestion researches question using Claude's web search tool.
+func (c *AnthropicClient) QueryQuestion(ctx context.Context, question string, kind QuestionKind) (*OracleResponse, error) {
+	if question == "" {
+		return nil, fmt.Errorf("question cannot be empty")
+	}
+
+	currentDate := time.Now().Format("January 2, 2006")
+	reqBody := anthropicRequest{
+		Model:     c.model,
+		MaxTokens: 2048,
+		System:    getSystemPrompt(currentDate),
+		Messages:  []anthropicMessage{{Role: "user", Content: question}},
+		Tools:     []anthropicTool{{Type: "web_search_20250305", Name: "web_search"}},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("anthropic-version", "2023-06-01")
+
+	if c.verbose {
+		fmt.Fprintf(os.Stderr, "\n🔍 Querying Anthropic (%s) with web search...\n", c.model)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var claudeResp anthropicResponse
+	if err := json.Unmarshal(body, &claudeResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	var text string
+	for _, block := range claudeResp.Content {
+		if block.Type == "text" {
+			text += block.Text
+		}
+	}
+
+	oracleResp, err := parseJSONResponse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract oracle response: %w", err)
+	}
+
+	if len(oracleResp.Sources) > 0 {
+		var quote string
+		oracleResp.Sources, quote = filterAndValidateSourcesFor(oracleResp.Sources, oracleResp.Value, 5, c.verbose)
+		oracleResp.Why = appendQuoteToWhy(oracleResp.Why, quote)
+	}
+
+	oracleResp.RawResponse = text
+	return oracleResp, nil
+}
@@ -0,0 +1,310 @@
+package search_agent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ProviderVote is one provider's answer to a question fanned out by an
+// Ensemble, kept even on failure so callers can show operators why a
+// provider didn't contribute.
+type ProviderVote struct {
+	Provider string
+	Response *OracleResponse
+	Err      error
+}
+
+// EnsembleResult is the outcome of querying multiple providers for the same
+// question: the value the ensemble is willing to stand behind (when Agreed
+// is true), plus every individual provider's answer for manual review.
+type EnsembleResult struct {
+	Value      string
+	Agreed     bool
+	Confidence float64
+	Votes      []ProviderVote
+
+	// Sources is the merged, deduplicated list of source URLs that at
+	// least two providers independently cited, run back through
+	// filterAndValidateSources. A single provider's solitary source isn't
+	// included - it hasn't been corroborated.
+	Sources []string
+	// SourceAgreement is the average pairwise Jaccard similarity between
+	// providers' source sets, a second, independent signal of agreement
+	// alongside Confidence: two providers can land on the same value from
+	// completely different evidence, which SourceAgreement exposes even
+	// when Confidence alone looks strong.
+	SourceAgreement float64
+}
+
+// Ensemble fans a question out to several Oracle providers and combines
+// their answers into a single recommendation, refusing to agree when the
+// providers disagree beyond Threshold.
+type Ensemble struct {
+	Providers []Oracle
+	// Threshold is the maximum disagreement (0-1) tolerated before Agreed
+	// is set to false: for yes/no questions, the fraction of dissenting
+	// votes; for numeric questions, the fraction each answer is allowed to
+	// deviate from the median.
+	Threshold float64
+	// Verbose enables the same source-validation logging
+	// filterAndValidateSources prints for a single provider's sources.
+	Verbose bool
+}
+
+// NewEnsemble builds an Ensemble over the given providers.
+func NewEnsemble(providers []Oracle, threshold float64, verbose bool) *Ensemble {
+	return &Ensemble{Providers: providers, Threshold: threshold, Verbose: verbose}
+}
+
+// Query fans the question out to every configured provider in parallel and
+// computes consensus per kind.
+func (e *Ensemble) Query(ctx context.Context, question string, kind QuestionKind) (*EnsembleResult, error) {
+	if len(e.Providers) == 0 {
+		return nil, fmt.Errorf("ensemble has no configured providers")
+	}
+
+	votes := make([]ProviderVote, len(e.Providers))
+	var wg sync.WaitGroup
+	for i, provider := range e.Providers {
+		wg.Add(1)
+		go func(i int, p Oracle) {
+			defer wg.Done()
+			resp, err := p.QueryQuestion(ctx, question, kind)
+			votes[i] = ProviderVote{Provider: p.Name(), Response: resp, Err: err}
+		}(i, provider)
+	}
+	wg.Wait()
+
+	var result *EnsembleResult
+	switch kind {
+	case KindYesNo:
+		result = consensusYesNo(votes, e.Threshold)
+	default:
+		result = consensusNumeric(votes, e.Threshold)
+	}
+
+	result.Sources, result.SourceAgreement = consensusSources(votes, e.Verbose)
+	return result, nil
+}
+
+func consensusYesNo(votes []ProviderVote, threshold float64) *EnsembleResult {
+	var yes, no, total int
+	for _, v := range votes {
+		if v.Err != nil || v.Response == nil {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(v.Response.Value)) {
+		case "yes":
+			yes++
+			total++
+		case "no":
+			no++
+			total++
+		}
+	}
+
+	result := &EnsembleResult{Votes: votes}
+	if total == 0 {
+		return result
+	}
+
+	majorityValue := "No"
+	majorityCount := no
+	if yes >= no {
+		majorityValue = "Yes"
+		majorityCount = yes
+	}
+
+	agreement := float64(majorityCount) / float64(total)
+	result.Value = majorityValue
+	result.Confidence = agreement
+	result.Agreed = agreement >= 1-threshold
+
+	return result
+}
+
+func consensusNumeric(votes []ProviderVote, threshold float64) *EnsembleResult {
+	var values []float64
+	for _, v := range votes {
+		if v.Err != nil || v.Response == nil {
+			continue
+		}
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v.Response.Value), 64); err == nil {
+			values = append(values, f)
+		}
+	}
+
+	result := &EnsembleResult{Votes: votes}
+	if len(values) == 0 {
+		return result
+	}
+
+	// Drop gross outliers before computing the median, so one wildly wrong
+	// provider answer doesn't drag the consensus value toward it.
+	filtered := filterOutliersMAD(values)
+
+	median := medianOf(filtered)
+	result.Value = formatFloat(median)
+
+	within := 0
+	for _, f := range values {
+		if median == 0 {
+			if f == 0 {
+				within++
+			}
+			continue
+		}
+		if math.Abs(f-median)/math.Abs(median) <= threshold {
+			within++
+		}
+	}
+
+	result.Confidence = float64(within) / float64(len(values))
+	result.Agreed = within == len(values)
+
+	return result
+}
+
+// filterOutliersMAD drops values whose modified z-score (Iglewicz &
+// Hoaglin's MAD-based rule) exceeds 3.5, the standard threshold for
+// flagging an outlier. With fewer than three values there isn't enough
+// data for the median-absolute-deviation to be meaningful, so it's
+// skipped.
+func filterOutliersMAD(values []float64) []float64 {
+	if len(values) < 3 {
+		return values
+	}
+
+	median := medianOf(values)
+	deviations := make([]float64, len(values))
+	for i, v := range values {
+		deviations[i] = math.Abs(v - median)
+	}
+	mad := medianOf(deviations)
+	if mad == 0 {
+		return values
+	}
+
+	const modifiedZThreshold = 3.5
+	filtered := make([]float64, 0, len(values))
+	for _, v := range values {
+		modifiedZ := 0.6745 * math.Abs(v-median) / mad
+		if modifiedZ <= modifiedZThreshold {
+			filtered = append(filtered, v)
+		}
+	}
+	if len(filtered) == 0 {
+		// Every value looked like an outlier relative to the others - fall
+		// back to the unfiltered set rather than computing a median of
+		// nothing.
+		return values
+	}
+	return filtered
+}
+
+// consensusSources merges every successful vote's sources, keeping only
+// URLs at least two providers independently cited - still passed back
+// through filterAndValidateSources so the merged list gets the same
+// cap/reachability check a single provider's sources do - and scores how
+// much the providers' source sets overlap via the average pairwise
+// Jaccard index.
+func consensusSources(votes []ProviderVote, verbose bool) (sources []string, agreement float64) {
+	var sourceSets [][]string
+	counts := make(map[string]int)
+	var order []string
+	for _, v := range votes {
+		if v.Err != nil || v.Response == nil || len(v.Response.Sources) == 0 {
+			continue
+		}
+		sourceSets = append(sourceSets, v.Response.Sources)
+		for _, src := range v.Response.Sources {
+			if counts[src] == 0 {
+				order = append(order, src)
+			}
+			counts[src]++
+		}
+	}
+
+	var merged []string
+	for _, src := range order {
+		if counts[src] >= 2 {
+			merged = append(merged, src)
+		}
+	}
+	if len(merged) > 0 {
+		merged = filterAndValidateSources(merged, 5, verbose)
+	}
+
+	return merged, averageJaccard(sourceSets)
+}
+
+// averageJaccard is the mean Jaccard similarity (|intersection| / |union|)
+// across every pair of sets, 0 when there are fewer than two sets to
+// compare.
+func averageJaccard(sets [][]string) float64 {
+	if len(sets) < 2 {
+		return 0
+	}
+
+	var total float64
+	var pairs int
+	for i := 0; i < len(sets); i++ {
+		for j := i + 1; j < len(sets); j++ {
+			total += jaccard(sets[i], sets[j])
+			pairs++
+		}
+	}
+	if pairs == 0 {
+		return 0
+	}
+	return total / float64(pairs)
+}
+
+func jaccard(a, b []string) float64 {
+	setA := make(map[string]bool, len(a))
+	for _, s := range a {
+		setA[s] = true
+	}
+	setB := make(map[string]bool, len(b))
+	for _, s := range b {
+		setB[s] = true
+	}
+
+	union := make(map[string]bool, len(setA)+len(setB))
+	intersection := 0
+	for s := range setA {
+		union[s] = true
+		if setB[s] {
+			intersection++
+		}
+	}
+	for s := range setB {
+		union[s] = true
+	}
+	if len(union) == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(len(union))
+}
+
+func medianOf(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func formatFloat(f float64) string {
+	if f == math.Trunc(f) {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,30 @@
+package search_agent
+
+import "fmt"
+
+// ProviderConfig carries the credentials/endpoints every known provider
+// might need. Callers typically build this straight from config.Config.
+type ProviderConfig struct {
+	GoogleAPIKey    string
+	OpenAIAPIKey    string
+	AnthropicAPIKey string
+	OllamaEndpoint  string
+	OllamaModel     string
+}
+
+// NewProvider constructs the named Oracle implementation. Known names are
+// "gemini", "openai", "anthropic", and "ollama".
+func NewProvider(name string, cfg ProviderConfig, verbose bool) (Oracle, error) {
+	switch name {
+	case "gemini":
+		return NewGeminiClient(cfg.GoogleAPIKey, verbose)
+	case "openai":
+		return NewOpenAIClient(cfg.OpenAIAPIKey, verbose)
+	case "anthropic":
+		return NewAnthropicClient(cfg.AnthropicAPIKey, verbose)
+	case "ollama":
+		return NewOllamaClient(cfg.OllamaEndpoint, cfg.OllamaModel, verbose)
+	default:
+		return nil, fmt.Errorf("unknown AI provider %q (expected gemini, openai, anthropic, or ollama)", name)
+	}
+}
@@ -1,7 +1,9 @@
 package search_agent
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -16,16 +18,38 @@ type OracleResponse struct {
 	Value   string   `json:"value"`
 	Sources []string `json:"sources"`
 	Why     string   `json:"why"`
+
+	// RawResponse is the exact, unparsed text the provider returned (before
+	// JSON extraction). It is not part of the AI's response schema - it is
+	// filled in by each provider after parsing - and is persisted by the
+	// research package for attestation, not by the AI itself.
+	RawResponse string `json:"-"`
+
+	// Votes and Confidence are filled in by queryConsensus, not by any
+	// single provider: they record every provider's individual answer and
+	// the Ensemble's agreement score for Value. Both are zero/empty on a
+	// single-provider response.
+	Votes      []ProviderVote `json:"-"`
+	Confidence float64        `json:"-"`
 }
 
 // GeminiClient wraps the Gemini API client for oracle queries
 type GeminiClient struct {
 	apiKey     string
 	apiURL     string
+	model      string
 	httpClient *http.Client
 	verbose    bool
+
+	// onProgress, if set, is called with each incremental chunk of answer
+	// text as it streams in over SSE, so a caller can render a live
+	// "typing" effect instead of waiting for the full response.
+	onProgress ProgressFunc
 }
 
+// ProgressFunc receives one incremental chunk of streamed answer text.
+type ProgressFunc func(chunk string)
+
 // API Request/Response structures for Gemini REST API
 type geminiRequest struct {
 	Contents         []geminiContent  `json:"contents"`
@@ -74,29 +98,57 @@ func NewGeminiClient(apiKey string, verbose bool) (*GeminiClient, error) {
 		return nil, fmt.Errorf("API key cannot be empty")
 	}
 
-	// Use gemini-2.5-flash which supports google_search
-	apiURL := "https://generativelanguage.googleapis.com/v1beta/models/gemini-2.5-flash:generateContent"
+	// Use gemini-2.5-flash which supports google_search. streamGenerateContent
+	// with alt=sse streams the answer incrementally instead of blocking on
+	// the full response, so long research answers don't need a single
+	// fixed deadline to cover the whole request.
+	apiURL := "https://generativelanguage.googleapis.com/v1beta/models/gemini-2.5-flash:streamGenerateContent?alt=sse"
 
+	// Client.Timeout bounds an entire request including the body read,
+	// which would defeat streaming by killing a long-running answer
+	// partway through. ResponseHeaderTimeout only bounds the wait for the
+	// initial response headers, leaving the body read governed solely by
+	// the caller's context.
 	httpClient := &http.Client{
-		Timeout: 60 * time.Second,
+		Transport: &http.Transport{
+			ResponseHeaderTimeout: 60 * time.Second,
+		},
 	}
 
 	return &GeminiClient{
 		apiKey:     apiKey,
 		apiURL:     apiURL,
+		model:      "gemini-2.5-flash",
 		httpClient: httpClient,
 		verbose:    verbose,
 	}, nil
 }
 
+// OnProgress registers fn to be called with each incremental chunk of
+// answer text as QueryQuestion streams it in. Passing nil clears any
+// previously registered callback.
+func (c *GeminiClient) OnProgress(fn ProgressFunc) {
+	c.onProgress = fn
+}
+
 // Close closes the Gemini client connection
 func (c *GeminiClient) Close() error {
 	// Nothing to close for HTTP client
 	return nil
 }
 
+// Name identifies this provider for display and consensus voting.
+func (c *GeminiClient) Name() string {
+	return "gemini"
+}
+
+// Model identifies the specific Gemini model queried.
+func (c *GeminiClient) Model() string {
+	return c.model
+}
+
 // QueryQuestion queries the AI with web search to answer the oracle question
-func (c *GeminiClient) QueryQuestion(question string) (*OracleResponse, error) {
+func (c *GeminiClient) QueryQuestion(ctx context.Context, question string, kind QuestionKind) (*OracleResponse, error) {
 	if question == "" {
 		return nil, fmt.Errorf("question cannot be empty")
 	}
@@ -144,7 +196,7 @@ func (c *GeminiClient) QueryQuestion(question string) (*OracleResponse, error) {
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequest("POST", c.apiURL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -152,47 +204,56 @@ func (c *GeminiClient) QueryQuestion(question string) (*OracleResponse, error) {
 	// Set headers
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("x-goog-api-key", c.apiKey)
+	req.Header.Set("Accept", "text/event-stream")
 
-	// Send request
+	// Send request. Do returns as soon as headers arrive - the body is
+	// streamed lazily, so ctx (not c.httpClient.Timeout) governs how long
+	// we're willing to keep reading it.
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if c.verbose {
-		fmt.Fprintf(os.Stderr, "📥 Response status: %d\n", resp.StatusCode)
-		fmt.Fprintf(os.Stderr, "📥 Response body:\n%s\n\n", string(body))
-	}
-
-	// Check for errors
 	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
 	}
 
-	// Parse response
-	var geminiResp geminiResponse
-	if err := json.Unmarshal(body, &geminiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+	responseText, metadataSources, scanner, err := c.consumeStream(resp.Body)
+	if err != nil {
+		return nil, err
 	}
-
-	// Extract text from response
-	if len(geminiResp.Candidates) == 0 || len(geminiResp.Candidates[0].Content.Parts) == 0 {
+	if responseText == "" {
 		return nil, fmt.Errorf("no response from Gemini")
 	}
 
-	responseText := geminiResp.Candidates[0].Content.Parts[0].Text
-
 	if c.verbose {
 		fmt.Fprintf(os.Stderr, "📝 Response text:\n%s\n\n", responseText)
 	}
 
+	// The scanner may already have pulled "value"/"sources"/"why" out of a
+	// response that was cut off right after the outer object's closing
+	// brace - treating that partial-but-complete JSON as success, same as
+	// a fully-buffered parseJSONResponse would.
+	if value, ok := scanner.stringField("value"); ok {
+		why, _ := scanner.stringField("why")
+		sources, _ := scanner.stringSliceField("sources")
+		if len(sources) == 0 {
+			sources = metadataSources
+		}
+		var quote string
+		if len(sources) > 0 {
+			sources, quote = filterAndValidateSourcesFor(sources, value, 5, c.verbose)
+		}
+		return &OracleResponse{
+			Value:       value,
+			Sources:     sources,
+			Why:         appendQuoteToWhy(why, quote),
+			RawResponse: responseText,
+		}, nil
+	}
+
 	// Try to parse as JSON
 	oracleResp, err := parseJSONResponse(responseText)
 	if err == nil {
@@ -201,21 +262,24 @@ func (c *GeminiClient) QueryQuestion(question string) (*OracleResponse, error) {
 			fmt.Fprintf(os.Stderr, "✓ Parsed JSON response\n")
 			fmt.Fprintf(os.Stderr, "  Sources in JSON: %d\n", len(oracleResp.Sources))
 		}
-		
-		// If sources are empty in JSON, try to extract from grounding metadata
+
+		// If sources are empty in JSON, fall back to grounding metadata
 		if len(oracleResp.Sources) == 0 {
-			metadataSources := extractSourcesFromMetadata(&geminiResp)
 			if c.verbose {
 				fmt.Fprintf(os.Stderr, "  Sources from metadata: %d\n", len(metadataSources))
 			}
 			oracleResp.Sources = metadataSources
 		}
-		
-		// Validate and filter sources
+
+		// Validate and filter sources, and look for a quote supporting the
+		// claimed value among them.
+		var quote string
 		if len(oracleResp.Sources) > 0 {
-			oracleResp.Sources = filterAndValidateSources(oracleResp.Sources, 5, c.verbose)
+			oracleResp.Sources, quote = filterAndValidateSourcesFor(oracleResp.Sources, oracleResp.Value, 5, c.verbose)
 		}
-		
+
+		oracleResp.Why = appendQuoteToWhy(oracleResp.Why, quote)
+		oracleResp.RawResponse = responseText
 		return oracleResp, nil
 	}
 
@@ -224,12 +288,6 @@ func (c *GeminiClient) QueryQuestion(question string) (*OracleResponse, error) {
 		fmt.Fprintf(os.Stderr, "⚠ Response is not JSON, extracting from plain text...\n\n")
 	}
 
-	// Extract sources from grounding metadata
-	sources := extractSourcesFromMetadata(&geminiResp)
-	if len(sources) > 0 {
-		sources = filterAndValidateSources(sources, 5, c.verbose)
-	}
-
 	// Extract value from first sentence or line
 	value := responseText
 	if idx := strings.Index(responseText, "."); idx != -1 && idx < 200 {
@@ -241,13 +299,75 @@ func (c *GeminiClient) QueryQuestion(question string) (*OracleResponse, error) {
 		}
 	}
 
+	// Validate sources from grounding metadata and look for a quote
+	// supporting the extracted value.
+	sources, quote := metadataSources, ""
+	if len(sources) > 0 {
+		sources, quote = filterAndValidateSourcesFor(sources, value, 5, c.verbose)
+	}
+
 	return &OracleResponse{
-		Value:   value,
-		Sources: sources,
-		Why:     strings.TrimSpace(responseText),
+		Value:       value,
+		Sources:     sources,
+		Why:         appendQuoteToWhy(strings.TrimSpace(responseText), quote),
+		RawResponse: responseText,
 	}, nil
 }
 
+// appendQuoteToWhy folds a quote found in a source's page into an existing
+// Why explanation, leaving Why untouched when no supporting quote was found.
+func appendQuoteToWhy(why, quote string) string {
+	if quote == "" {
+		return why
+	}
+	if why == "" {
+		return fmt.Sprintf("Supporting quote: %q", quote)
+	}
+	return fmt.Sprintf("%s\n\nSupporting quote: %q", why, quote)
+}
+
+// consumeStream reads an SSE response body line by line, feeding each
+// chunk's text delta through a streamJSONScanner and accumulating grounding
+// metadata sources along the way. It returns normally whether the stream
+// ends with a clean EOF (the server closed the connection once its answer
+// was complete) or mid-object (the scanner simply won't have every field
+// yet) - ctx cancellation surfaces here as a read error from resp.Body,
+// since req was built with http.NewRequestWithContext.
+func (c *GeminiClient) consumeStream(body io.Reader) (text string, sources []string, scanner *streamJSONScanner, err error) {
+	reader := bufio.NewReader(body)
+	scanner = newStreamJSONScanner()
+	var answer strings.Builder
+
+	for {
+		line, readErr := reader.ReadString('\n')
+		line = strings.TrimSpace(line)
+
+		if data, ok := strings.CutPrefix(line, "data: "); ok && data != "" {
+			var chunk geminiResponse
+			if jsonErr := json.Unmarshal([]byte(data), &chunk); jsonErr == nil && len(chunk.Candidates) > 0 {
+				cand := chunk.Candidates[0]
+				for _, part := range cand.Content.Parts {
+					answer.WriteString(part.Text)
+					scanner.Feed(part.Text)
+					if c.onProgress != nil {
+						c.onProgress(part.Text)
+					}
+				}
+				if metaSources := extractSourcesFromMetadata(&chunk); len(metaSources) > 0 {
+					sources = append(sources, metaSources...)
+				}
+			}
+		}
+
+		if readErr != nil {
+			if readErr == io.EOF {
+				return answer.String(), sources, scanner, nil
+			}
+			return answer.String(), sources, scanner, fmt.Errorf("failed to read stream: %w", readErr)
+		}
+	}
+}
+
 // getSystemPrompt generates the system prompt with current date
 func getSystemPrompt(currentDate string) string {
 	return fmt.Sprintf(`You are an AI research agent designed to answer questions for an optimistic oracle proposer.
@@ -391,82 +511,8 @@ func extractSourcesFromMetadata(resp *geminiResponse) []string {
 	return sources
 }
 
-// validateURL checks if a URL is accessible (doesn't return 404 or error)
-// Reproduces the exact behavior from the Python PoC
-func validateURL(url string, timeout time.Duration) bool {
-	// Simple HTTP client with timeout
-	client := &http.Client{
-		Timeout: timeout,
-	}
-
-	// Create GET request with User-Agent header
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return false
-	}
-
-	// Set User-Agent to avoid being blocked (same as Python PoC)
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
-
-	// Send request
-	resp, err := client.Do(req)
-	if err != nil {
-		return false
-	}
-	defer resp.Body.Close()
-
-	// Check if status is 200 OK (same as Python PoC)
-	return resp.StatusCode == 200
-}
-
-// filterAndValidateSources validates URLs and limits to maxSources
-func filterAndValidateSources(sources []string, maxSources int, verbose bool) []string {
-	if len(sources) == 0 {
-		return []string{}
-	}
-
-	validated := make([]string, 0, maxSources)
-
-	if verbose {
-		fmt.Fprintf(os.Stderr, "\n🔍 Validating %d sources (checking for 404 errors)...\n", len(sources))
-	}
-
-	for i, url := range sources {
-		if len(validated) >= maxSources {
-			break
-		}
-
-		if verbose {
-			fmt.Fprintf(os.Stderr, "  [%d/%d] Checking: %s\n", i+1, min(len(sources), maxSources), url)
-		}
-
-		if validateURL(url, 5*time.Second) {
-			validated = append(validated, url)
-			if verbose {
-				fmt.Fprintf(os.Stderr, "    ✓ Valid\n")
-			}
-		} else {
-			if verbose {
-				fmt.Fprintf(os.Stderr, "    ✗ Error (404 or unreachable)\n")
-			}
-		}
-	}
-
-	if verbose {
-		fmt.Fprintf(os.Stderr, "✓ %d valid sources found\n\n", len(validated))
-	} else if len(sources) > 0 {
-		// In non-verbose mode, show a summary
-		fmt.Fprintf(os.Stderr, "✓ %d valid sources (out of %d found)\n", len(validated), len(sources))
-	}
-
-	return validated
-}
-
-// min returns the minimum of two integers
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
+// filterAndValidateSources and filterAndValidateSourcesFor, which every
+// QueryQuestion above calls, now live in validator.go behind a
+// SourceValidator that checks robots.txt, soft-404s, and page content
+// instead of just GETting each URL and checking for a 200.
 
@@ -0,0 +1,129 @@
+package search_agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// OllamaClient queries a local (or self-hosted) Ollama endpoint. It has no
+// web-search capability, so it should only be trusted for questions the
+// model can answer from training data, or combined with other providers in
+// an Ensemble.
+type OllamaClient struct {
+	endpoint   string
+	model      string
+	httpClient *http.Client
+	verbose    bool
+}
+
+type ollamaRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	System string `json:"system"`
+	Stream bool   `json:"stream"`
+}
+
+type ollamaResponse struct {
+	Response string `json:"response"`
+}
+
+// NewOllamaClient creates a new Ollama client for oracle queries.
+// endpoint defaults to "http://localhost:11434" when empty.
+func NewOllamaClient(endpoint, model string, verbose bool) (*OllamaClient, error) {
+	if endpoint == "" {
+		endpoint = "http://localhost:11434"
+	}
+	if model == "" {
+		model = "llama3.1"
+	}
+
+	return &OllamaClient{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		model:      model,
+		httpClient: &http.Client{Timeout: 120 * time.Second},
+		verbose:    verbose,
+	}, nil
+}
+
+// Name identifies this provider for display and consensus voting.
+func (c *OllamaClient) Name() string {
+	return "ollama"
+}
+
+// Model identifies the specific local model queried.
+func (c *OllamaClient) Model() string {
+	return c.model
+}
+
+// Close releases the client's resources.
+func (c *OllamaClient) Close() error {
+	return nil
+}
+
+// QueryQuestion researches question using a local Ollama model.
+func (c *OllamaClient) QueryQuestion(ctx context.Context, question string, kind QuestionKind) (*OracleResponse, error) {
+	if question == "" {
+		return nil, fmt.Errorf("question cannot be empty")
+	}
+
+	currentDate := time.Now().Format("January 2, 2006")
+	reqBody := ollamaRequest{
+		Model:  c.model,
+		Prompt: question,
+		System: getSystemPrompt(currentDate),
+		Stream: false,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.endpoint+"/api/generate", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if c.verbose {
+		fmt.Fprintf(os.Stderr, "\n🔍 Querying Ollama (%s) at %s...\n", c.model, c.endpoint)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach ollama at %s: %w", c.endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ollama error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var ollamaResp ollamaResponse
+	if err := json.Unmarshal(body, &ollamaResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	oracleResp, err := parseJSONResponse(ollamaResp.Response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract oracle response: %w", err)
+	}
+
+	// Ollama has no web search tool; it cannot ground sources.
+	oracleResp.Sources = nil
+
+	oracleResp.RawResponse = ollamaResp.Response
+	return oracleResp, nil
+}
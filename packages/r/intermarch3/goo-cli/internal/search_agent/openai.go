@@ -0,0 +1,133 @@
+package search_agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+)
+
+// OpenAIClient queries OpenAI's Responses API (input/instructions in,
+// output_text out) with the `web_search` tool enabled for browsing-backed
+// research.
+type OpenAIClient struct {
+	apiKey     string
+	apiURL     string
+	model      string
+	httpClient *http.Client
+	verbose    bool
+}
+
+type openAIRequest struct {
+	Model  string             `json:"model"`
+	Input  string             `json:"input"`
+	Tools  []openAITool       `json:"tools,omitempty"`
+	Instructions string       `json:"instructions,omitempty"`
+}
+
+type openAITool struct {
+	Type string `json:"type"`
+}
+
+type openAIResponse struct {
+	OutputText string `json:"output_text"`
+}
+
+// NewOpenAIClient creates a new OpenAI client for oracle queries.
+func NewOpenAIClient(apiKey string, verbose bool) (*OpenAIClient, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("API key cannot be empty")
+	}
+
+	return &OpenAIClient{
+		apiKey:     apiKey,
+		apiURL:     "https://api.openai.com/v1/responses",
+		model:      "gpt-4o-search-preview",
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+		verbose:    verbose,
+	}, nil
+}
+
+// Name identifies this provider for display and consensus voting.
+func (c *OpenAIClient) Name() string {
+	return "openai"
+}
+
+// Model identifies the specific OpenAI model queried.
+func (c *OpenAIClient) Model() string {
+	return c.model
+}
+
+// Close releases the client's resources.
+func (c *OpenAIClient) Close() error {
+	return nil
+}
+
+// QueryQuestion researches question using OpenAI's browsing-enabled model.
+func (c *OpenAIClient) QueryQuestion(ctx context.Context, question string, kind QuestionKind) (*OracleResponse, error) {
+	if question == "" {
+		return nil, fmt.Errorf("question cannot be empty")
+	}
+
+	currentDate := time.Now().Format("January 2, 2006")
+	reqBody := openAIRequest{
+		Model:        c.model,
+		Instructions: getSystemPrompt(currentDate),
+		Input:        question,
+		Tools:        []openAITool{{Type: "web_search"}},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	if c.verbose {
+		fmt.Fprintf(os.Stderr, "\n🔍 Querying OpenAI (%s) with web search...\n", c.model)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	var openaiResp openAIResponse
+	if err := json.Unmarshal(body, &openaiResp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	oracleResp, err := parseJSONResponse(openaiResp.OutputText)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract oracle response: %w", err)
+	}
+
+	if len(oracleResp.Sources) > 0 {
+		var quote string
+		oracleResp.Sources, quote = filterAndValidateSourcesFor(oracleResp.Sources, oracleResp.Value, 5, c.verbose)
+		oracleResp.Why = appendQuoteToWhy(oracleResp.Why, quote)
+	}
+
+	oracleResp.RawResponse = openaiResp.OutputText
+	return oracleResp, nil
+}
@@ -0,0 +1,33 @@
+package search_agent
+
+import "context"
+
+// QuestionKind tells a provider (and the consensus logic in Ensemble) how to
+// interpret and normalize the answer it produces.
+type QuestionKind int
+
+const (
+	// KindNumeric expects a bare number (see isValidNumber).
+	KindNumeric QuestionKind = iota
+	// KindYesNo expects "Yes" or "No".
+	KindYesNo
+)
+
+// Oracle is implemented by every AI research backend the proposer can draw
+// on. Providers are expected to return OracleResponse.Value already
+// normalized per kind (a pure number, or "Yes"/"No") wherever possible, and
+// to use the FUTURE_QUESTION_ERROR / "INSUFFICIENT DATA" sentinels from the
+// Gemini provider for the cases the caller already handles specially.
+type Oracle interface {
+	// Name identifies the provider for display and for per-provider votes
+	// in Ensemble results, e.g. "gemini", "openai".
+	Name() string
+	// Model identifies the specific model queried, e.g. "gemini-2.5-flash".
+	// Recorded alongside Name in the research attestation so a cached run
+	// can be tied to the exact model version that produced it.
+	Model() string
+	// QueryQuestion researches question and returns a proposed value.
+	QueryQuestion(ctx context.Context, question string, kind QuestionKind) (*OracleResponse, error)
+	// Close releases any resources held by the client.
+	Close() error
+}
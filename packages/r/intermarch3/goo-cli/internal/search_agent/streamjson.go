@@ -0,0 +1,203 @@
+package search_agent
+
+import "encoding/json"
+
+// streamJSONScanner incrementally extracts top-level fields from a growing
+// buffer of JSON text without waiting for the whole document to arrive.
+// Gemini's streaming endpoint delivers the model's answer - itself a JSON
+// object like {"value": "...", "sources": [...], "why": "..."} - a few
+// characters at a time, so the naive json.Unmarshal approach can't return
+// anything until the very last byte shows up. Feed rescans the whole buffer
+// from the outer object's opening brace each call and records a field's raw
+// JSON text the moment its value closes, so "value" can be available well
+// before "why" has finished streaming in.
+type streamJSONScanner struct {
+	buf    []byte
+	fields map[string]string
+}
+
+func newStreamJSONScanner() *streamJSONScanner {
+	return &streamJSONScanner{fields: make(map[string]string)}
+}
+
+// Feed appends chunk to the buffer and rescans for newly-completed
+// top-level fields, returning the names that completed on this call.
+func (s *streamJSONScanner) Feed(chunk string) []string {
+	s.buf = append(s.buf, chunk...)
+	return s.scan()
+}
+
+// scan walks the buffer from the outer object's opening brace, stopping
+// the instant it hits a field whose value hasn't fully arrived yet - the
+// rest of the buffer simply gets rescanned from the top on the next Feed.
+func (s *streamJSONScanner) scan() []string {
+	start := indexByte(s.buf, '{')
+	if start < 0 {
+		return nil
+	}
+
+	var newly []string
+	i := skipSpace(s.buf, start+1)
+	for i < len(s.buf) {
+		if s.buf[i] == '}' {
+			break
+		}
+		if s.buf[i] == ',' {
+			i = skipSpace(s.buf, i+1)
+			continue
+		}
+		if s.buf[i] != '"' {
+			break
+		}
+
+		keyEnd, ok := scanJSONString(s.buf, i)
+		if !ok {
+			break
+		}
+		key := string(s.buf[i+1 : keyEnd-1])
+
+		i = skipSpace(s.buf, keyEnd)
+		if i >= len(s.buf) || s.buf[i] != ':' {
+			break
+		}
+		i = skipSpace(s.buf, i+1)
+		if i >= len(s.buf) {
+			break
+		}
+
+		valEnd, ok := scanJSONValue(s.buf, i)
+		if !ok {
+			break
+		}
+		if _, done := s.fields[key]; !done {
+			s.fields[key] = string(s.buf[i:valEnd])
+			newly = append(newly, key)
+		}
+		i = skipSpace(s.buf, valEnd)
+	}
+
+	return newly
+}
+
+// stringField decodes a completed field as a JSON string, false if the
+// field hasn't closed yet (or was never a string).
+func (s *streamJSONScanner) stringField(key string) (string, bool) {
+	raw, ok := s.fields[key]
+	if !ok {
+		return "", false
+	}
+	var v string
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return "", false
+	}
+	return v, true
+}
+
+// stringSliceField decodes a completed field as a JSON array of strings.
+func (s *streamJSONScanner) stringSliceField(key string) ([]string, bool) {
+	raw, ok := s.fields[key]
+	if !ok {
+		return nil, false
+	}
+	var v []string
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+func indexByte(buf []byte, b byte) int {
+	for i, c := range buf {
+		if c == b {
+			return i
+		}
+	}
+	return -1
+}
+
+func skipSpace(buf []byte, i int) int {
+	for i < len(buf) {
+		switch buf[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+			continue
+		}
+		break
+	}
+	return i
+}
+
+// scanJSONString expects buf[start] == '"' and returns the index just past
+// the matching unescaped closing quote, ok=false if the string is still
+// streaming in (buffer ends before it closes).
+func scanJSONString(buf []byte, start int) (end int, ok bool) {
+	i := start + 1
+	for i < len(buf) {
+		switch buf[i] {
+		case '\\':
+			i += 2
+			continue
+		case '"':
+			return i + 1, true
+		}
+		i++
+	}
+	return 0, false
+}
+
+// scanJSONValue returns the index just past a complete JSON value starting
+// at buf[start] (string, object, array, or bare literal), ok=false if the
+// value hasn't fully arrived yet.
+func scanJSONValue(buf []byte, start int) (end int, ok bool) {
+	if start >= len(buf) {
+		return 0, false
+	}
+
+	switch buf[start] {
+	case '"':
+		return scanJSONString(buf, start)
+	case '{', '[':
+		open, close := buf[start], closingBracket(buf[start])
+		depth := 0
+		i := start
+		for i < len(buf) {
+			switch buf[i] {
+			case '"':
+				strEnd, ok := scanJSONString(buf, i)
+				if !ok {
+					return 0, false
+				}
+				i = strEnd
+				continue
+			case open:
+				depth++
+			case close:
+				depth--
+				if depth == 0 {
+					return i + 1, true
+				}
+			}
+			i++
+		}
+		return 0, false
+	default:
+		// A bare literal (number, true, false, null): its end is only known
+		// once we see the delimiter that follows it.
+		i := start
+		for i < len(buf) {
+			switch buf[i] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				return i, true
+			}
+			i++
+		}
+		return 0, false
+	}
+}
+
+func closingBracket(open byte) byte {
+	if open == '{' {
+		return '}'
+	}
+	return ']'
+}
@@ -0,0 +1,460 @@
+package search_agent
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	sourceCacheTTL   = 24 * time.Hour
+	robotsTTL        = time.Hour
+	maxRedirects     = 5
+	minSupportedBody = 200 // bytes of extracted main text below which a 200 is treated as a soft-404
+)
+
+// SourceValidator checks that a cited URL is actually reachable and worth
+// trusting, and - when a value is given to check for - looks in its text for
+// a quote supporting the oracle's answer. It replaces the bare "GET and check for 200" that
+// validateURL used to do with something closer to what a human fact-checker
+// would: honor robots.txt, follow redirects, catch soft-404 pages that
+// return 200 anyway, and pull out a supporting quote instead of just a
+// yes/no "reachable".
+//
+// Results are memoized under ~/.goo/cache/urls so re-running the same
+// proposal doesn't re-fetch every source from scratch.
+type SourceValidator struct {
+	client    *http.Client
+	cacheDir  string
+	robotsMu  sync.Mutex
+	robots    map[string]*robotsRules
+}
+
+// robotsRules is the small subset of robots.txt this cares about: the
+// Disallow paths for User-agent: *, refreshed per host after robotsTTL.
+type robotsRules struct {
+	disallow []string
+	expiry   time.Time
+}
+
+var (
+	defaultValidatorOnce sync.Once
+	defaultValidatorInst *SourceValidator
+)
+
+// defaultValidator returns the process-wide SourceValidator, built lazily so
+// a cache directory is only created once it's actually needed.
+func defaultValidator() *SourceValidator {
+	defaultValidatorOnce.Do(func() {
+		defaultValidatorInst = NewSourceValidator()
+	})
+	return defaultValidatorInst
+}
+
+// NewSourceValidator builds a SourceValidator backed by ~/.goo/cache/urls.
+// If the home directory can't be resolved, caching is silently disabled -
+// every lookup is treated as a miss and nothing is persisted.
+func NewSourceValidator() *SourceValidator {
+	v := &SourceValidator{
+		client: &http.Client{
+			Timeout: 10 * time.Second,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return fmt.Errorf("stopped after %d redirects", maxRedirects)
+				}
+				return nil
+			},
+		},
+		robots: make(map[string]*robotsRules),
+	}
+
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		dir := filepath.Join(homeDir, ".goo", "cache", "urls")
+		if err := os.MkdirAll(dir, 0755); err == nil {
+			v.cacheDir = dir
+		}
+	}
+
+	return v
+}
+
+// urlCacheEntry is what's memoized per URL, keyed by the URL itself and
+// revalidated against ETag once its TTL lapses.
+type urlCacheEntry struct {
+	URL       string    `json:"url"`
+	ETag      string    `json:"etag,omitempty"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Valid     bool      `json:"valid"`
+	// MainText is the extracted article/main text, capped, kept so a later
+	// Validate call with a different oracle value can still search it for
+	// a supporting quote without re-fetching the page.
+	MainText string `json:"main_text,omitempty"`
+}
+
+// filterAndValidateSources validates sources against live fetches -
+// following redirects, honoring robots.txt, rejecting soft-404s - and
+// returns at most maxSources of them that passed, in their original order.
+func filterAndValidateSources(sources []string, maxSources int, verbose bool) []string {
+	validated, _ := defaultValidator().Validate(sources, "", maxSources, verbose)
+	return validated
+}
+
+// filterAndValidateSourcesFor is filterAndValidateSources plus a search for
+// a quote supporting value in the validated pages' main text - the first
+// one found is returned so the caller can fold it into OracleResponse.Why.
+func filterAndValidateSourcesFor(sources []string, value string, maxSources int, verbose bool) ([]string, string) {
+	return defaultValidator().Validate(sources, value, maxSources, verbose)
+}
+
+// Validate fetches each source (until maxSources have passed), in order,
+// and returns the ones that are robots-allowed and not a soft-404. When
+// value is non-empty their main text is also searched for a supporting
+// quote, but a source without one is still returned. The first supporting
+// quote found across all sources is returned alongside.
+func (v *SourceValidator) Validate(sources []string, value string, maxSources int, verbose bool) ([]string, string) {
+	if len(sources) == 0 {
+		return []string{}, ""
+	}
+
+	if verbose {
+		fmt.Fprintf(os.Stderr, "\n🔍 Validating %d sources (robots.txt, soft-404, content check)...\n", len(sources))
+	}
+
+	validated := make([]string, 0, maxSources)
+	var quote string
+
+	for i, src := range sources {
+		if len(validated) >= maxSources {
+			break
+		}
+
+		if verbose {
+			fmt.Fprintf(os.Stderr, "  [%d/%d] Checking: %s\n", i+1, len(sources), src)
+		}
+
+		if !v.robotsAllowed(src) {
+			if verbose {
+				fmt.Fprintf(os.Stderr, "    ✗ Disallowed by robots.txt\n")
+			}
+			continue
+		}
+
+		entry, ok := v.fetch(src)
+		if !ok || !entry.Valid {
+			if verbose {
+				fmt.Fprintf(os.Stderr, "    ✗ Error, soft-404, or unreachable\n")
+			}
+			continue
+		}
+
+		validated = append(validated, src)
+		if verbose {
+			fmt.Fprintf(os.Stderr, "    ✓ Valid\n")
+		}
+		if quote == "" {
+			if found, ok := findSupportingQuote(entry.MainText, value); ok {
+				quote = found
+			}
+		}
+	}
+
+	if verbose {
+		fmt.Fprintf(os.Stderr, "✓ %d valid sources found\n\n", len(validated))
+	} else if len(sources) > 0 {
+		fmt.Fprintf(os.Stderr, "✓ %d valid sources (out of %d found)\n", len(validated), len(sources))
+	}
+
+	return validated, quote
+}
+
+// fetch returns the cache entry for src, refreshing it from the network
+// when the cached copy is missing or older than sourceCacheTTL.
+func (v *SourceValidator) fetch(src string) (*urlCacheEntry, bool) {
+	cached, hasCached := v.readCache(src)
+	if hasCached && time.Since(cached.FetchedAt) < sourceCacheTTL {
+		return cached, true
+	}
+
+	entry, err := v.fetchLive(src, cached)
+	if err != nil {
+		return nil, false
+	}
+
+	v.writeCache(entry)
+	return entry, true
+}
+
+// fetchLive performs the actual HTTP GET and soft-404/content analysis. If
+// cached is non-nil, its ETag is sent as If-None-Match so a 304 can reuse
+// the cached validity/text without re-downloading or re-parsing anything.
+func (v *SourceValidator) fetchLive(src string, cached *urlCacheEntry) (*urlCacheEntry, error) {
+	req, err := http.NewRequest(http.MethodGet, src, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36")
+	req.Header.Set("Accept-Encoding", "gzip")
+	if cached != nil && cached.ETag != "" {
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		refreshed := *cached
+		refreshed.FetchedAt = time.Now()
+		return &refreshed, nil
+	}
+
+	entry := &urlCacheEntry{
+		URL:       src,
+		ETag:      resp.Header.Get("ETag"),
+		FetchedAt: time.Now(),
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return entry, nil
+	}
+
+	var bodyReader io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return entry, nil
+		}
+		defer gz.Close()
+		bodyReader = gz
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(bodyReader, 2<<20)) // cap at 2MiB
+	if err != nil {
+		return entry, nil
+	}
+	html := string(raw)
+
+	title := extractTitle(html)
+	mainText := extractMainText(html)
+
+	if isSoftNotFound(title, mainText) {
+		return entry, nil
+	}
+
+	const maxCachedText = 4000
+	if len(mainText) > maxCachedText {
+		mainText = mainText[:maxCachedText]
+	}
+
+	entry.Valid = true
+	entry.MainText = mainText
+	return entry, nil
+}
+
+// robotsAllowed reports whether src's host's robots.txt allows fetching it,
+// failing open (allowed) whenever robots.txt can't be fetched or parsed.
+func (v *SourceValidator) robotsAllowed(src string) bool {
+	u, err := url.Parse(src)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return true
+	}
+	origin := u.Scheme + "://" + u.Host
+
+	v.robotsMu.Lock()
+	rules, ok := v.robots[origin]
+	v.robotsMu.Unlock()
+
+	if !ok || time.Now().After(rules.expiry) {
+		rules = v.fetchRobots(origin)
+		v.robotsMu.Lock()
+		v.robots[origin] = rules
+		v.robotsMu.Unlock()
+	}
+
+	for _, disallow := range rules.disallow {
+		if disallow != "" && strings.HasPrefix(u.Path, disallow) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *SourceValidator) fetchRobots(origin string) *robotsRules {
+	rules := &robotsRules{expiry: time.Now().Add(robotsTTL)}
+
+	resp, err := v.client.Get(origin + "/robots.txt")
+	if err != nil {
+		return rules
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return rules
+	}
+
+	appliesToUs := false
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.ToLower(strings.TrimSpace(parts[0]))
+		val := strings.TrimSpace(parts[1])
+
+		switch key {
+		case "user-agent":
+			appliesToUs = val == "*"
+		case "disallow":
+			if appliesToUs && val != "" {
+				rules.disallow = append(rules.disallow, val)
+			}
+		}
+	}
+
+	return rules
+}
+
+// readCache loads the memoized entry for src, false if there isn't one or
+// it can't be read.
+func (v *SourceValidator) readCache(src string) (*urlCacheEntry, bool) {
+	if v.cacheDir == "" {
+		return nil, false
+	}
+	data, err := os.ReadFile(v.cachePath(src))
+	if err != nil {
+		return nil, false
+	}
+	var entry urlCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// writeCache persists entry, best-effort - a cache write failure shouldn't
+// fail the validation it's memoizing.
+func (v *SourceValidator) writeCache(entry *urlCacheEntry) {
+	if v.cacheDir == "" {
+		return
+	}
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(v.cachePath(entry.URL), data, 0644)
+}
+
+func (v *SourceValidator) cachePath(src string) string {
+	sum := sha256.Sum256([]byte(src))
+	return filepath.Join(v.cacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+var (
+	titleRe       = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	scriptStyleRe = regexp.MustCompile(`(?is)<(script|style|nav|header|footer)[^>]*>.*?</(script|style|nav|header|footer)>`)
+	articleRe     = regexp.MustCompile(`(?is)<(article|main)[^>]*>(.*?)</(article|main)>`)
+	tagRe         = regexp.MustCompile(`(?is)<[^>]+>`)
+	spaceRe       = regexp.MustCompile(`\s+`)
+)
+
+var notFoundPatterns = []string{
+	"404", "not found", "page not found", "doesn't exist", "does not exist",
+	"no longer available", "content unavailable", "page unavailable",
+}
+
+// extractTitle pulls the <title> text out of raw HTML, empty if there is
+// none.
+func extractTitle(html string) string {
+	m := titleRe.FindStringSubmatch(html)
+	if m == nil {
+		return ""
+	}
+	return strings.TrimSpace(stripTags(m[1]))
+}
+
+// extractMainText strips script/style/nav/header/footer blocks, then keeps
+// the longest <article>/<main> block if one exists (a readability-style
+// heuristic for "this is the actual content, not chrome around it"),
+// falling back to the whole stripped document otherwise.
+func extractMainText(html string) string {
+	cleaned := scriptStyleRe.ReplaceAllString(html, "")
+
+	var body string
+	if matches := articleRe.FindAllStringSubmatch(cleaned, -1); len(matches) > 0 {
+		for _, m := range matches {
+			if len(m[2]) > len(body) {
+				body = m[2]
+			}
+		}
+	} else {
+		body = cleaned
+	}
+
+	return normalizeWhitespace(stripTags(body))
+}
+
+func stripTags(html string) string {
+	return tagRe.ReplaceAllString(html, " ")
+}
+
+func normalizeWhitespace(text string) string {
+	return strings.TrimSpace(spaceRe.ReplaceAllString(text, " "))
+}
+
+// isSoftNotFound reports whether a 200 response actually looks like a
+// "not found" page: a suspiciously thin body, or a title that matches a
+// known not-found phrase.
+func isSoftNotFound(title, mainText string) bool {
+	lowerTitle := strings.ToLower(title)
+	for _, pattern := range notFoundPatterns {
+		if strings.Contains(lowerTitle, pattern) {
+			return true
+		}
+	}
+	return len(mainText) < minSupportedBody
+}
+
+// findSupportingQuote looks for value inside mainText and, if present,
+// returns a short window of surrounding text suitable for OracleResponse.Why.
+func findSupportingQuote(mainText, value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || mainText == "" {
+		return "", false
+	}
+
+	idx := strings.Index(strings.ToLower(mainText), strings.ToLower(value))
+	if idx == -1 {
+		return "", false
+	}
+
+	const window = 120
+	start := idx - window
+	if start < 0 {
+		start = 0
+	}
+	end := idx + len(value) + window
+	if end > len(mainText) {
+		end = len(mainText)
+	}
+
+	quote := strings.TrimSpace(mainText[start:end])
+	return quote, true
+}
@@ -0,0 +1,157 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"goo-cli/internal/config"
+)
+
+// catalog maps a locale ("en", "fr", ...) to its ErrorCode -> friendly
+// message table. "en" always exists; other locales may be partial, since
+// friendlyMessage falls back to "en" for any code a locale doesn't cover.
+var catalog = map[string]map[ErrorCode]string{
+	"en": enMessages,
+	"fr": frMessages,
+}
+
+// RegisterMessages adds or overrides the message table for lang, merging
+// messages into whatever that locale already has. Lets a deployment ship
+// its own locale (or patch wording of an existing one) without a CLI
+// rebuild's worth of source changes living in this file.
+func RegisterMessages(lang string, messages map[ErrorCode]string) {
+	existing, ok := catalog[lang]
+	if !ok {
+		existing = map[ErrorCode]string{}
+		catalog[lang] = existing
+	}
+	for code, msg := range messages {
+		existing[code] = msg
+	}
+}
+
+// activeLanguage resolves the locale ParseContractError renders messages
+// in: $LANG (e.g. "fr_FR.UTF-8" -> "fr") takes priority over the
+// language: field in ~/.goo/config.yaml, so a user's shell environment
+// always wins over a checked-in config file.
+func activeLanguage() string {
+	if lang := os.Getenv("LANG"); lang != "" {
+		code := strings.SplitN(lang, ".", 2)[0]
+		code = strings.SplitN(code, "_", 2)[0]
+		if code != "" && code != "C" && code != "POSIX" {
+			return strings.ToLower(code)
+		}
+	}
+
+	cfg := config.Load()
+	if cfg.Language != "" {
+		return cfg.Language
+	}
+	return "en"
+}
+
+// friendlyMessage looks up code in the active locale, falling back to
+// English and then to a generic, still-useful message carrying the code
+// and raw detail.
+func friendlyMessage(code ErrorCode, detail string) string {
+	lang := activeLanguage()
+
+	if msgs, ok := catalog[lang]; ok {
+		if msg, ok := msgs[code]; ok {
+			return msg
+		}
+	}
+	if msg, ok := enMessages[code]; ok {
+		return msg
+	}
+
+	if detail != "" {
+		return fmt.Sprintf("❌ %s (code: %s)", detail, code)
+	}
+	return fmt.Sprintf("❌ Error (code: %s)", code)
+}
+
+var enMessages = map[ErrorCode]string{
+	ErrAncillaryDataEmpty:  "❌ Question/ancillary data is required",
+	ErrDeadlineTooSoon:     "❌ Deadline must be at least 24 hours from now",
+	ErrIncorrectReward:     "❌ Incorrect reward amount (check with 'goo query params')",
+	ErrRequestNotFound:     "❌ Request not found - invalid request ID",
+	ErrInvalidRequestState: "❌ Request is not available for proposals (may be already proposed, disputed, or resolved)",
+	ErrProposalWindowEnded: "❌ Proposal deadline has passed",
+	ErrNotYetProposed:      "❌ No proposal submitted for this request yet",
+	ErrRequestResolved:     "❌ Request is already resolved",
+	ErrFundsAlreadyTaken:   "❌ Cannot retrieve funds - request has been fulfilled",
+	ErrNotRequester:        "❌ Only the request creator can retrieve the fund",
+	ErrDeadlineNotReached:  "❌ Cannot retrieve fund - deadline not reached yet",
+
+	ErrInvalidYesNoValue:      "❌ For yes/no questions, value must be 0 (no) or 1 (yes)",
+	ErrIncorrectBond:          "❌ Incorrect bond amount (check with 'goo query params')",
+	ErrResolutionWindowActive: "❌ Cannot resolve yet - resolution period still active",
+	ErrSelfDispute:            "❌ You cannot dispute your own proposal",
+	ErrInvalidProposalState:   "❌ Request is not in proposed state (may be already disputed or resolved)",
+	ErrDisputeWindowEnded:     "❌ Dispute period has ended",
+	ErrDisputeAlreadyExists:   "❌ This request is already disputed",
+	ErrDisputeResolved:        "❌ Dispute is already resolved",
+	ErrDisputeWindowActive:    "❌ Dispute period has not ended yet",
+	ErrNotResolved:            "❌ Request is not resolved yet - cannot get result",
+	ErrDisputeNotFound:        "❌ Dispute not found - invalid dispute ID",
+
+	ErrAlreadyHasVoteToken:     "❌ You already own a vote token",
+	ErrIncorrectVoteTokenPrice: "❌ Incorrect vote token price (check with 'goo query params')",
+	ErrSelfVote:                "❌ Proposers and disputers cannot vote on their own disputes",
+	ErrAlreadyVoted:            "❌ You have already voted in this dispute",
+	ErrNoVoteToken:             "❌ You need to buy a vote token first ('goo vote buy-token')",
+	ErrVoteWindowEnded:         "❌ Voting period has ended",
+	ErrVoteWindowActive:        "❌ Cannot reveal yet - voting period still active",
+	ErrRevealWindowEnded:       "❌ Reveal period has ended",
+	ErrDidNotVote:              "❌ You did not vote in this dispute",
+	ErrAlreadyRevealed:         "❌ Vote already revealed",
+	ErrHashMismatch:            "❌ Hash mismatch - value or salt incorrect (check ~/.goo/votes/)",
+
+	ErrUnauthorized: "❌ Admin privileges required",
+	ErrInternal:     "❌ Internal error - realm context required",
+	ErrQueryFailed:  "❌ Failed to query contract",
+}
+
+var frMessages = map[ErrorCode]string{
+	ErrAncillaryDataEmpty:  "❌ La question/donnée annexe est requise",
+	ErrDeadlineTooSoon:     "❌ L'échéance doit être à au moins 24 heures",
+	ErrIncorrectReward:     "❌ Montant de récompense incorrect (voir 'goo query params')",
+	ErrRequestNotFound:     "❌ Demande introuvable - identifiant invalide",
+	ErrInvalidRequestState: "❌ Cette demande n'est pas disponible pour une proposition (déjà proposée, contestée ou résolue)",
+	ErrProposalWindowEnded: "❌ L'échéance de proposition est dépassée",
+	ErrNotYetProposed:      "❌ Aucune proposition soumise pour cette demande",
+	ErrRequestResolved:     "❌ Cette demande est déjà résolue",
+	ErrFundsAlreadyTaken:   "❌ Impossible de récupérer les fonds - la demande a déjà été honorée",
+	ErrNotRequester:        "❌ Seul le créateur de la demande peut récupérer les fonds",
+	ErrDeadlineNotReached:  "❌ Impossible de récupérer les fonds - l'échéance n'est pas atteinte",
+
+	ErrInvalidYesNoValue:      "❌ Pour une question oui/non, la valeur doit être 0 (non) ou 1 (oui)",
+	ErrIncorrectBond:          "❌ Montant de caution incorrect (voir 'goo query params')",
+	ErrResolutionWindowActive: "❌ Résolution impossible - la période de résolution est toujours active",
+	ErrSelfDispute:            "❌ Vous ne pouvez pas contester votre propre proposition",
+	ErrInvalidProposalState:   "❌ Cette demande n'est pas à l'état proposé (déjà contestée ou résolue)",
+	ErrDisputeWindowEnded:     "❌ La période de contestation est terminée",
+	ErrDisputeAlreadyExists:   "❌ Cette demande est déjà contestée",
+	ErrDisputeResolved:        "❌ Cette contestation est déjà résolue",
+	ErrDisputeWindowActive:    "❌ La période de contestation n'est pas encore terminée",
+	ErrNotResolved:            "❌ Cette demande n'est pas encore résolue",
+	ErrDisputeNotFound:        "❌ Contestation introuvable - identifiant invalide",
+
+	ErrAlreadyHasVoteToken:     "❌ Vous possédez déjà un jeton de vote",
+	ErrIncorrectVoteTokenPrice: "❌ Prix du jeton de vote incorrect (voir 'goo query params')",
+	ErrSelfVote:                "❌ Les proposants et contestataires ne peuvent pas voter sur leur propre contestation",
+	ErrAlreadyVoted:            "❌ Vous avez déjà voté sur cette contestation",
+	ErrNoVoteToken:             "❌ Vous devez d'abord acheter un jeton de vote ('goo vote buy-token')",
+	ErrVoteWindowEnded:         "❌ La période de vote est terminée",
+	ErrVoteWindowActive:        "❌ Révélation impossible - la période de vote est toujours active",
+	ErrRevealWindowEnded:       "❌ La période de révélation est terminée",
+	ErrDidNotVote:              "❌ Vous n'avez pas voté sur cette contestation",
+	ErrAlreadyRevealed:         "❌ Vote déjà révélé",
+	ErrHashMismatch:            "❌ Hachage invalide - valeur ou sel incorrect (voir ~/.goo/votes/)",
+
+	ErrUnauthorized: "❌ Privilèges administrateur requis",
+	ErrInternal:     "❌ Erreur interne - contexte de realm requis",
+	ErrQueryFailed:  "❌ Échec de la requête sur le contrat",
+}
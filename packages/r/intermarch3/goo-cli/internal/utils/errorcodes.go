@@ -0,0 +1,55 @@
+package utils
+
+// ErrorCode identifies a stable contract/transport failure category. It's
+// what ParseContractError switches on internally to pick a localized
+// message, and what *ContractError.Code exposes so programmatic callers
+// can branch on the failure without string-matching Friendly.
+type ErrorCode string
+
+const (
+	ErrUnknown ErrorCode = "unknown"
+
+	// Request errors
+	ErrAncillaryDataEmpty  ErrorCode = "ancillary_data_empty"
+	ErrDeadlineTooSoon     ErrorCode = "deadline_too_soon"
+	ErrIncorrectReward     ErrorCode = "incorrect_reward"
+	ErrRequestNotFound     ErrorCode = "request_not_found"
+	ErrInvalidRequestState ErrorCode = "invalid_request_state"
+	ErrProposalWindowEnded ErrorCode = "proposal_window_ended"
+	ErrNotYetProposed      ErrorCode = "not_yet_proposed"
+	ErrRequestResolved     ErrorCode = "request_resolved"
+	ErrFundsAlreadyTaken   ErrorCode = "funds_already_taken"
+	ErrNotRequester        ErrorCode = "not_requester"
+	ErrDeadlineNotReached  ErrorCode = "deadline_not_reached"
+
+	// Proposal/dispute errors
+	ErrInvalidYesNoValue      ErrorCode = "invalid_yesno_value"
+	ErrIncorrectBond          ErrorCode = "incorrect_bond"
+	ErrResolutionWindowActive ErrorCode = "resolution_window_active"
+	ErrSelfDispute            ErrorCode = "self_dispute"
+	ErrInvalidProposalState   ErrorCode = "invalid_proposal_state"
+	ErrDisputeWindowEnded     ErrorCode = "dispute_window_ended"
+	ErrDisputeAlreadyExists   ErrorCode = "dispute_already_exists"
+	ErrDisputeResolved        ErrorCode = "dispute_resolved"
+	ErrDisputeWindowActive    ErrorCode = "dispute_window_active"
+	ErrNotResolved            ErrorCode = "not_resolved"
+	ErrDisputeNotFound        ErrorCode = "dispute_not_found"
+
+	// Vote errors
+	ErrAlreadyHasVoteToken     ErrorCode = "already_has_vote_token"
+	ErrIncorrectVoteTokenPrice ErrorCode = "incorrect_vote_token_price"
+	ErrSelfVote                ErrorCode = "self_vote"
+	ErrAlreadyVoted            ErrorCode = "already_voted"
+	ErrNoVoteToken             ErrorCode = "no_vote_token"
+	ErrVoteWindowEnded         ErrorCode = "vote_window_ended"
+	ErrVoteWindowActive        ErrorCode = "vote_window_active"
+	ErrRevealWindowEnded       ErrorCode = "reveal_window_ended"
+	ErrDidNotVote              ErrorCode = "did_not_vote"
+	ErrAlreadyRevealed         ErrorCode = "already_revealed"
+	ErrHashMismatch            ErrorCode = "hash_mismatch"
+
+	// Admin/transport errors
+	ErrUnauthorized ErrorCode = "unauthorized"
+	ErrInternal     ErrorCode = "internal"
+	ErrQueryFailed  ErrorCode = "query_failed"
+)
@@ -2,16 +2,96 @@ package utils
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 )
 
-// ContractError represents a user-friendly error message
+// ContractError is what ParseContractError returns: Code is stable across
+// contract wording changes and switchable by programmatic callers, Detail
+// is whatever the realm (or gnokey) actually said, and Friendly is the
+// localized, human-facing message HandleError prints.
 type ContractError struct {
-	Original string
+	Code     ErrorCode
+	Detail   string
 	Friendly string
+	Cause    error
 }
 
-// ParseContractError converts contract error messages to user-friendly messages
+func (e *ContractError) Error() string { return e.Friendly }
+
+func (e *ContractError) Unwrap() error { return e.Cause }
+
+// structuredErrorPattern matches the "ERR:<code>:<detail>" convention a
+// realm can emit so the CLI doesn't have to string-match its wording.
+// Older realm versions that don't emit this yet fall through to
+// legacyPatterns below.
+var structuredErrorPattern = regexp.MustCompile(`ERR:([A-Za-z0-9_]+):([^\n]*)`)
+
+// legacyPatterns is the original substring table, kept so pre-upgrade
+// realms (which only ever produced English prose, never an ERR: code)
+// still get classified and localized instead of falling through to a raw
+// dump of gnokey's output.
+var legacyPatterns = []struct {
+	substr string
+	code   ErrorCode
+}{
+	// Request errors
+	{"Ancillary data cannot be empty", ErrAncillaryDataEmpty},
+	{"Deadline must be at least 24 hours in the future", ErrDeadlineTooSoon},
+	{"Incorrect reward amount sent", ErrIncorrectReward},
+	{"Request with this ID does not exist", ErrRequestNotFound},
+	{"Request is not in 'Requested' state", ErrInvalidRequestState},
+	{"Deadline for proposal has passed", ErrProposalWindowEnded},
+	{"Request has not been proposed yet", ErrNotYetProposed},
+	{"Request is already resolved", ErrRequestResolved},
+	{"cannot retreive fund as requests fulfilled", ErrFundsAlreadyTaken},
+	{"Only the creator of the request can retrieve the fund", ErrNotRequester},
+	{"Cannot retrieve fund before the deadline", ErrDeadlineNotReached},
+
+	// Proposal errors
+	{"Proposed value must be 0 or 1 for yes/no questions", ErrInvalidYesNoValue},
+	{"Incorrect bond amount sent", ErrIncorrectBond},
+	{"Resolution period has not ended yet", ErrResolutionWindowActive},
+	{"Request is in 'Disputed' state", ErrDisputeWindowActive},
+	{"Proposer cannot dispute their own proposal", ErrSelfDispute},
+	{"Request is not in 'Proposed' state", ErrInvalidProposalState},
+	{"Dispute period has ended", ErrDisputeWindowEnded},
+	{"Dispute for this request already exists", ErrDisputeAlreadyExists},
+	{"Dispute is already resolved", ErrDisputeResolved},
+	{"Dispute period has not ended yet", ErrDisputeWindowActive},
+	{"Request is not resolved", ErrNotResolved},
+
+	// Vote errors
+	{"You already have a vote token", ErrAlreadyHasVoteToken},
+	{"Must send exactly", ErrIncorrectVoteTokenPrice},
+	{"Proposer and Disputer cannot vote in this dispute", ErrSelfVote},
+	{"Voter has already voted in this dispute", ErrAlreadyVoted},
+	{"You need at least 1 vote token to vote", ErrNoVoteToken},
+	{"Vote period has ended", ErrVoteWindowEnded},
+	{"Vote period has not ended yet", ErrVoteWindowActive},
+	{"Reveal period has ended", ErrRevealWindowEnded},
+	{"Voter did not participate in this dispute", ErrDidNotVote},
+	{"Vote already revealed", ErrAlreadyRevealed},
+	{"Hash does not match the revealed value and salt", ErrHashMismatch},
+	{"Dispute with this ID does not exist", ErrDisputeNotFound},
+	{"Dispute is resolved", ErrDisputeResolved},
+
+	// Admin errors
+	{"Only the admin can", ErrUnauthorized},
+	{"Only admin can", ErrUnauthorized},
+
+	// General errors
+	{"missing realm argument", ErrInternal},
+	{"query failed", ErrQueryFailed},
+	{"failed to query", ErrQueryFailed},
+}
+
+// ParseContractError converts a raw gnokey/contract error into a
+// *ContractError carrying a stable Code and a localized Friendly message.
+// It first looks for the "ERR:<code>:<detail>" convention a realm can emit
+// directly, then falls back to substring-matching legacyPatterns for
+// realm versions that only produce English prose, and finally cleans up
+// unrecognized gnokey output as best it can.
 func ParseContractError(err error) error {
 	if err == nil {
 		return nil
@@ -19,63 +99,15 @@ func ParseContractError(err error) error {
 
 	errMsg := err.Error()
 
-	// Common contract error patterns with user-friendly messages
-	errorMappings := map[string]string{
-		// Request errors
-		"Ancillary data cannot be empty":                         "❌ Question/ancillary data is required",
-		"Deadline must be at least 24 hours in the future":      "❌ Deadline must be at least 24 hours from now",
-		"Incorrect reward amount sent":                          "❌ Incorrect reward amount (check with 'goo query params')",
-		"Request with this ID does not exist":                   "❌ Request not found - invalid request ID",
-		"Request is not in 'Requested' state":                   "❌ Request is not available for proposals (may be already proposed, disputed, or resolved)",
-		"Deadline for proposal has passed":                      "❌ Proposal deadline has passed",
-		"Request has not been proposed yet":                     "❌ No proposal submitted for this request yet",
-		"Request is already resolved":                           "❌ Request is already resolved",
-		"cannot retreive fund as requests fulfilled":            "❌ Cannot retrieve funds - request has been fulfilled",
-		"Only the creator of the request can retrieve the fund": "❌ Only the request creator can retrieve the fund",
-		"Cannot retrieve fund before the deadline":              "❌ Cannot retrieve fund - deadline not reached yet",
-
-		// Proposal errors
-		"Proposed value must be 0 or 1 for yes/no questions":  "❌ For yes/no questions, value must be 0 (no) or 1 (yes)",
-		"Incorrect bond amount sent":                          "❌ Incorrect bond amount (check with 'goo query params')",
-		"Resolution period has not ended yet":                 "❌ Cannot resolve yet - resolution period still active",
-		"Request is in 'Disputed' state":                      "❌ Cannot resolve - request is disputed",
-		"Proposer cannot dispute their own proposal":          "❌ You cannot dispute your own proposal",
-		"Request is not in 'Proposed' state":                  "❌ Request is not in proposed state (may be already disputed or resolved)",
-		"Dispute period has ended":                            "❌ Dispute period has ended",
-		"Dispute for this request already exists":             "❌ This request is already disputed",
-		"Dispute is already resolved":                         "❌ Dispute is already resolved",
-		"Dispute period has not ended yet":                    "❌ Dispute period has not ended yet",
-		"Request is not resolved":                             "❌ Request is not resolved yet - cannot get result",
-
-		// Vote errors
-		"You already have a vote token":                            "❌ You already own a vote token",
-		"Must send exactly":                                        "❌ Incorrect vote token price (check with 'goo query params')",
-		"Proposer and Disputer cannot vote in this dispute":       "❌ Proposers and disputers cannot vote on their own disputes",
-		"Voter has already voted in this dispute":                 "❌ You have already voted in this dispute",
-		"You need at least 1 vote token to vote":                  "❌ You need to buy a vote token first ('goo vote buy-token')",
-		"Vote period has ended":                                   "❌ Voting period has ended",
-		"Vote period has not ended yet":                           "❌ Cannot reveal yet - voting period still active",
-		"Reveal period has ended":                                 "❌ Reveal period has ended",
-		"Voter did not participate in this dispute":               "❌ You did not vote in this dispute",
-		"Vote already revealed":                                   "❌ Vote already revealed",
-		"Hash does not match the revealed value and salt":         "❌ Hash mismatch - value or salt incorrect (check ~/.goo/votes/)",
-		"Dispute with this ID does not exist":                     "❌ Dispute not found - invalid dispute ID",
-		"Dispute is resolved":                                     "❌ Dispute is already resolved",
-
-		// Admin errors
-		"Only the admin can": "❌ Admin privileges required",
-		"Only admin can":     "❌ Admin privileges required",
-
-		// General errors
-		"missing realm argument": "❌ Internal error - realm context required",
-		"query failed":           "❌ Query failed",
-		"failed to query":        "❌ Failed to query contract",
+	if m := structuredErrorPattern.FindStringSubmatch(errMsg); m != nil {
+		code := ErrorCode(strings.ToLower(m[1]))
+		detail := strings.TrimSpace(m[2])
+		return &ContractError{Code: code, Detail: detail, Friendly: friendlyMessage(code, detail), Cause: err}
 	}
 
-	// Check for each error pattern
-	for pattern, friendlyMsg := range errorMappings {
-		if strings.Contains(errMsg, pattern) {
-			return fmt.Errorf("%s", friendlyMsg)
+	for _, p := range legacyPatterns {
+		if strings.Contains(errMsg, p.substr) {
+			return &ContractError{Code: p.code, Detail: errMsg, Friendly: friendlyMessage(p.code, ""), Cause: err}
 		}
 	}
 
@@ -86,15 +118,15 @@ func ParseContractError(err error) error {
 			// Find the end of the error message
 			rest := errMsg[idx+7:] // Skip "error: "
 			if endIdx := strings.Index(rest, "\n"); endIdx != -1 {
-				cleanMsg := strings.TrimSpace(rest[:endIdx])
-				return fmt.Errorf("❌ Contract error: %s", cleanMsg)
+				detail := strings.TrimSpace(rest[:endIdx])
+				return &ContractError{Code: ErrUnknown, Detail: detail, Friendly: fmt.Sprintf("❌ Contract error: %s", detail), Cause: err}
 			}
 		}
 		if idx := strings.Index(errMsg, "Data:"); idx != -1 {
 			rest := errMsg[idx+5:]
 			if endIdx := strings.Index(rest, "\n"); endIdx != -1 {
-				cleanMsg := strings.TrimSpace(rest[:endIdx])
-				return fmt.Errorf("❌ %s", cleanMsg)
+				detail := strings.TrimSpace(rest[:endIdx])
+				return &ContractError{Code: ErrUnknown, Detail: detail, Friendly: fmt.Sprintf("❌ %s", detail), Cause: err}
 			}
 		}
 	}
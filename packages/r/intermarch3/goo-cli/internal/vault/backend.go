@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Backend is the storage surface a Vault writes its encrypted blobs
+// through. FileBackend (the default) durably writes to the local
+// filesystem; a future OS-keyring backend can satisfy the same interface
+// to keep vote secrets out of plain files entirely without changing
+// anything above it.
+type Backend interface {
+	Save(path string, data []byte) error
+	Load(path string) ([]byte, error)
+	List(dir string) ([]string, error)
+	Remove(path string) error
+}
+
+// FileBackend persists blobs to the local filesystem.
+type FileBackend struct{}
+
+// Save writes data to path atomically: it lands in a temp file in the
+// same directory, is fsynced, then renamed over the destination, so a
+// crash mid-write never leaves a torn vote record behind. The containing
+// directory is also fsynced afterward so the rename itself survives a
+// crash.
+func (FileBackend) Save(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create vault directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write vault record: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to fsync vault record: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close vault record: %w", err)
+	}
+	if err := os.Chmod(tmpPath, 0600); err != nil {
+		return fmt.Errorf("failed to set vault record permissions: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to commit vault record: %w", err)
+	}
+
+	if dirHandle, err := os.Open(dir); err == nil {
+		dirHandle.Sync()
+		dirHandle.Close()
+	}
+	return nil
+}
+
+func (FileBackend) Load(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// List returns the names (not full paths) of every file directly in dir.
+// A missing dir is not an error - it just means there's nothing to list.
+func (FileBackend) List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// Remove deletes path. Removing a file that's already gone is not an
+// error, since the caller's goal (the file not existing) is already met.
+func (FileBackend) Remove(path string) error {
+	err := os.Remove(path)
+	if err != nil && os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
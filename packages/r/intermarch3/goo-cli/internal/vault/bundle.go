@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Bundle is a portable snapshot of a vault's encrypted vote records, used
+// to move votes between machines (e.g. recovering onto a new device)
+// without ever decrypting them in transit - the destination only needs
+// the same vault passphrase to Reveal() afterward.
+type Bundle struct {
+	ChainID string       `json:"chain_id"`
+	KeyName string       `json:"key_name"`
+	Records []fileRecord `json:"records"`
+}
+
+// Export writes every vote record currently in the vault to outPath as a
+// single JSON bundle. Records stay encrypted; Export does not need (and
+// does not ask for) the vault passphrase.
+func (v *Vault) Export(outPath string) error {
+	idx, err := v.loadIndex()
+	if err != nil {
+		return err
+	}
+
+	bundle := Bundle{ChainID: v.ChainID, KeyName: v.KeyName}
+	for requestID := range idx.Entries {
+		fr, err := v.readFileRecord(requestID)
+		if err != nil {
+			continue
+		}
+		bundle.Records = append(bundle.Records, *fr)
+	}
+
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal vote bundle: %w", err)
+	}
+	if err := os.WriteFile(outPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write vote bundle: %w", err)
+	}
+	return nil
+}
+
+// Import merges every record in the bundle at inPath into the vault,
+// overwriting any existing record for the same request ID, and returns
+// how many records were imported. Records stay encrypted throughout;
+// Import does not need the vault passphrase either.
+func (v *Vault) Import(inPath string) (int, error) {
+	data, err := os.ReadFile(inPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read vote bundle: %w", err)
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return 0, fmt.Errorf("failed to parse vote bundle: %w", err)
+	}
+
+	for _, fr := range bundle.Records {
+		encoded, err := json.MarshalIndent(fr, "", "  ")
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal vote record %s: %w", fr.RequestID, err)
+		}
+		path := v.path(fr.RequestID)
+		if err := v.backend.Save(path, encoded); err != nil {
+			return 0, fmt.Errorf("failed to import vote record %s: %w", fr.RequestID, err)
+		}
+		if err := v.upsertIndex(fr, filepath.Base(path)); err != nil {
+			return 0, fmt.Errorf("failed to update index for %s: %w", fr.RequestID, err)
+		}
+	}
+
+	return len(bundle.Records), nil
+}
@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Argon2id parameters for deriving a vault record's keys from its
+// passphrase. These match the RFC 9106 "low memory" recommendation, a
+// reasonable default for a CLI tool run on a laptop rather than a server
+// with memory to spare.
+const (
+	argon2Time    = 1
+	argon2Memory  = 64 * 1024 // KiB
+	argon2Threads = 4
+
+	saltSize       = 16
+	keyMaterialLen = chacha20poly1305.KeySize + sha256.Size
+)
+
+// deriveKeys stretches passphrase (salted by salt, random per record) into
+// two independent keys: one for ChaCha20-Poly1305 encryption and one for
+// the record's tamper-detection HMAC. Splitting them means a leak of one
+// key alone doesn't also compromise the other property.
+func deriveKeys(passphrase string, salt []byte) (encKey, hmacKey []byte) {
+	material := argon2.IDKey([]byte(passphrase), salt, argon2Time, argon2Memory, argon2Threads, keyMaterialLen)
+	return material[:chacha20poly1305.KeySize], material[chacha20poly1305.KeySize:]
+}
+
+func seal(encKey, nonce, plaintext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(encKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init AEAD: %w", err)
+	}
+	return aead.Seal(nil, nonce, plaintext, nil), nil
+}
+
+func open(encKey, nonce, ciphertext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(encKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init AEAD: %w", err)
+	}
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt vault record (wrong passphrase?): %w", err)
+	}
+	return plaintext, nil
+}
+
+// computeHMAC authenticates parts (concatenated in order) under hmacKey.
+func computeHMAC(hmacKey []byte, parts ...[]byte) []byte {
+	mac := hmac.New(sha256.New, hmacKey)
+	for _, p := range parts {
+		mac.Write(p)
+	}
+	return mac.Sum(nil)
+}
+
+// verifyHMAC reports whether sum is the valid HMAC of parts under hmacKey,
+// using a constant-time comparison.
+func verifyHMAC(hmacKey, sum []byte, parts ...[]byte) bool {
+	return hmac.Equal(sum, computeHMAC(hmacKey, parts...))
+}
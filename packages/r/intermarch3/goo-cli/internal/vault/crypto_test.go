@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSealOpenRoundTrip(t *testing.T) {
+	encKey, _ := deriveKeys("correct horse battery staple", []byte("0123456789abcdef"))
+	nonce := []byte("unique nonce")
+	plaintext := []byte("a vote salt worth protecting")
+
+	ciphertext, err := seal(encKey, nonce, plaintext)
+	if err != nil {
+		t.Fatalf("seal failed: %v", err)
+	}
+
+	decrypted, err := open(encKey, nonce, ciphertext)
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Fatalf("round trip mismatch: got %q, want %q", decrypted, plaintext)
+	}
+}
+
+func TestOpenRejectsTamperedCiphertext(t *testing.T) {
+	encKey, _ := deriveKeys("correct horse battery staple", []byte("0123456789abcdef"))
+	nonce := []byte("unique nonce")
+
+	ciphertext, err := seal(encKey, nonce, []byte("a vote salt worth protecting"))
+	if err != nil {
+		t.Fatalf("seal failed: %v", err)
+	}
+
+	tampered := bytes.Clone(ciphertext)
+	tampered[0] ^= 0xFF
+
+	if _, err := open(encKey, nonce, tampered); err == nil {
+		t.Fatal("expected open to reject tampered ciphertext, got no error")
+	}
+}
+
+func TestOpenRejectsWrongKey(t *testing.T) {
+	encKey, _ := deriveKeys("correct horse battery staple", []byte("0123456789abcdef"))
+	wrongKey, _ := deriveKeys("wrong passphrase", []byte("0123456789abcdef"))
+	nonce := []byte("unique nonce")
+
+	ciphertext, err := seal(encKey, nonce, []byte("a vote salt worth protecting"))
+	if err != nil {
+		t.Fatalf("seal failed: %v", err)
+	}
+
+	if _, err := open(wrongKey, nonce, ciphertext); err == nil {
+		t.Fatal("expected open to reject the wrong key, got no error")
+	}
+}
+
+func TestVerifyHMACDetectsTamper(t *testing.T) {
+	_, hmacKey := deriveKeys("correct horse battery staple", []byte("0123456789abcdef"))
+	salt := []byte("salt-part")
+	nonce := []byte("nonce-part")
+	ciphertext := []byte("ciphertext-part")
+
+	sum := computeHMAC(hmacKey, salt, nonce, ciphertext)
+	if !verifyHMAC(hmacKey, sum, salt, nonce, ciphertext) {
+		t.Fatal("verifyHMAC rejected an untampered sum")
+	}
+
+	tamperedCiphertext := bytes.Clone(ciphertext)
+	tamperedCiphertext[0] ^= 0xFF
+	if verifyHMAC(hmacKey, sum, salt, nonce, tamperedCiphertext) {
+		t.Fatal("verifyHMAC accepted a sum computed over different parts")
+	}
+}
+
+func TestDeriveKeysDeterministic(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+
+	encKey1, hmacKey1 := deriveKeys("correct horse battery staple", salt)
+	encKey2, hmacKey2 := deriveKeys("correct horse battery staple", salt)
+	if !bytes.Equal(encKey1, encKey2) || !bytes.Equal(hmacKey1, hmacKey2) {
+		t.Fatal("deriveKeys produced different keys for the same passphrase and salt")
+	}
+
+	encKey3, hmacKey3 := deriveKeys("correct horse battery staple", []byte("fedcba9876543210"))
+	if bytes.Equal(encKey1, encKey3) || bytes.Equal(hmacKey1, hmacKey3) {
+		t.Fatal("deriveKeys produced the same keys for different salts")
+	}
+}
@@ -0,0 +1,147 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const indexFileName = "index.json"
+
+// indexEntry is the scheduling metadata List()/Prune() need for one vote,
+// cached in the index file so reading it doesn't require opening (let
+// alone decrypting) every vote record in the vault.
+type indexEntry struct {
+	RequestID      string    `json:"request_id"`
+	CommittedAt    time.Time `json:"committed_at"`
+	RevealDeadline time.Time `json:"reveal_deadline"`
+	File           string    `json:"file"`
+}
+
+type voteIndex struct {
+	Entries map[string]indexEntry `json:"entries"`
+}
+
+func (v *Vault) indexPath() string {
+	return filepath.Join(v.Dir, indexFileName)
+}
+
+// loadIndex reads the vault's index file, rebuilding it from the vote
+// files on disk if it's missing or unreadable. That rebuild is what keeps
+// an older vault directory (predating the index) or one with a corrupted
+// index file usable without manual repair.
+func (v *Vault) loadIndex() (*voteIndex, error) {
+	data, err := v.backend.Load(v.indexPath())
+	if err != nil {
+		return v.rebuildIndex()
+	}
+
+	var idx voteIndex
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return v.rebuildIndex()
+	}
+	if idx.Entries == nil {
+		idx.Entries = map[string]indexEntry{}
+	}
+	return &idx, nil
+}
+
+func (v *Vault) saveIndex(idx *voteIndex) error {
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal vault index: %w", err)
+	}
+	return v.backend.Save(v.indexPath(), data)
+}
+
+// rebuildIndex scans every vote file in the vault directory and
+// reconstructs the index from their clear-text scheduling metadata.
+func (v *Vault) rebuildIndex() (*voteIndex, error) {
+	names, err := v.backend.List(v.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault directory: %w", err)
+	}
+
+	idx := &voteIndex{Entries: map[string]indexEntry{}}
+	for _, name := range names {
+		if !strings.HasSuffix(name, ".json.enc") {
+			continue
+		}
+		requestID := strings.TrimSuffix(name, ".json.enc")
+		fr, err := v.readFileRecord(requestID)
+		if err != nil {
+			continue
+		}
+		idx.Entries[requestID] = indexEntry{
+			RequestID:      fr.RequestID,
+			CommittedAt:    fr.CommittedAt,
+			RevealDeadline: fr.RevealDeadline,
+			File:           name,
+		}
+	}
+	return idx, nil
+}
+
+// upsertIndex records or updates requestID's entry and persists the index.
+func (v *Vault) upsertIndex(fr fileRecord, fileName string) error {
+	idx, err := v.loadIndex()
+	if err != nil {
+		return err
+	}
+	idx.Entries[fr.RequestID] = indexEntry{
+		RequestID:      fr.RequestID,
+		CommittedAt:    fr.CommittedAt,
+		RevealDeadline: fr.RevealDeadline,
+		File:           fileName,
+	}
+	return v.saveIndex(idx)
+}
+
+// List returns the scheduling metadata for every record in the vault,
+// without requiring a passphrase to decrypt the secret fields.
+func (v *Vault) List() ([]Record, error) {
+	idx, err := v.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]Record, 0, len(idx.Entries))
+	for _, entry := range idx.Entries {
+		records = append(records, Record{
+			RequestID:      entry.RequestID,
+			CommittedAt:    entry.CommittedAt,
+			RevealDeadline: entry.RevealDeadline,
+		})
+	}
+	return records, nil
+}
+
+// Prune removes every vote record whose reveal window closed before now,
+// returning the request IDs it removed. A vote past its reveal deadline
+// can no longer be revealed on chain, so there's nothing left worth
+// recovering by keeping it around.
+func (v *Vault) Prune(now time.Time) ([]string, error) {
+	idx, err := v.loadIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []string
+	for requestID, entry := range idx.Entries {
+		if entry.RevealDeadline.IsZero() || now.Before(entry.RevealDeadline) {
+			continue
+		}
+		if err := v.backend.Remove(filepath.Join(v.Dir, entry.File)); err != nil {
+			return removed, fmt.Errorf("failed to remove expired vote %s: %w", requestID, err)
+		}
+		delete(idx.Entries, requestID)
+		removed = append(removed, requestID)
+	}
+
+	if err := v.saveIndex(idx); err != nil {
+		return removed, err
+	}
+	return removed, nil
+}
@@ -0,0 +1,246 @@
+package vault
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// SeedStore holds the 128-bit secret a commit's salt can be deterministically
+// derived from, encrypted at rest the same way a vault record is (Argon2id
+// key derivation, ChaCha20-Poly1305, HMAC-SHA256 tamper detection) under
+// ~/.goo/seed. Unlike a vault record, there's only ever one of these per
+// machine - it backs every request's salt, not just one.
+//
+// The seed itself is shown to the user the moment it's generated, encoded as
+// a BIP39 mnemonic (ExportMnemonic can show it again, given the
+// passphrase): as long as that mnemonic and the
+// passphrase are remembered, every salt this seed ever derived can be
+// reproduced even if ~/.goo is lost entirely.
+type SeedStore struct {
+	path string
+}
+
+// seedFile is SeedStore's on-disk encoding - structurally identical to a
+// vault fileRecord's crypto envelope, reused here rather than invented
+// fresh since it's the same threat model (a passphrase-derived key
+// protecting a small secret, with tamper detection over the parts that
+// can't be authenticated by the AEAD alone).
+type seedFile struct {
+	KDFSalt    string `json:"kdf_salt"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+	HMAC       string `json:"hmac"`
+}
+
+// SeedPath returns ~/.goo/seed, the single encrypted seed file shared by
+// every request's deterministic salt derivation.
+func SeedPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".goo", "seed"), nil
+}
+
+// OpenSeedStore returns the SeedStore for this machine. It does not require
+// that a seed has actually been generated yet - see Exists.
+func OpenSeedStore() (*SeedStore, error) {
+	path, err := SeedPath()
+	if err != nil {
+		return nil, err
+	}
+	return &SeedStore{path: path}, nil
+}
+
+// Exists reports whether a seed has already been generated on this machine.
+func (s *SeedStore) Exists() bool {
+	_, err := os.Stat(s.path)
+	return err == nil
+}
+
+// Generate creates a new 128-bit seed, encrypts it at rest under
+// passphrase, and returns its BIP39 mnemonic. Callers must show this
+// mnemonic to the user immediately - the seed is stored only encrypted
+// under passphrase, so ExportMnemonic can re-derive the mnemonic only with
+// that passphrase, and Generate itself never needs to be called again once
+// it has.
+func (s *SeedStore) Generate(passphrase string) (mnemonic string, err error) {
+	entropy, err := bip39.NewEntropy(128)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate seed entropy: %w", err)
+	}
+	mnemonic, err = bip39.NewMnemonic(entropy)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode seed as a mnemonic: %w", err)
+	}
+	if err := s.save(entropy, passphrase); err != nil {
+		return "", err
+	}
+	return mnemonic, nil
+}
+
+// ImportMnemonic re-derives the 128-bit seed from a previously-shown
+// mnemonic and (re-)encrypts it at rest under passphrase - for recovering
+// a lost ~/.goo/seed, or carrying one over to a new machine.
+func (s *SeedStore) ImportMnemonic(mnemonic, passphrase string) error {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return fmt.Errorf("invalid mnemonic")
+	}
+	entropy, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return fmt.Errorf("failed to decode mnemonic: %w", err)
+	}
+	return s.save(entropy, passphrase)
+}
+
+// ExportMnemonic decrypts the stored seed and re-encodes it as its BIP39
+// mnemonic, for writing down as a backup or carrying over to another
+// machine.
+func (s *SeedStore) ExportMnemonic(passphrase string) (string, error) {
+	entropy, err := s.load(passphrase)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// DeriveVote reproduces the deterministic (salt, hash) commit-reveal pair
+// for requestID/value from this seed alone:
+//
+//	salt = HKDF-SHA256(seed, info = chainID || realmPath || requestID || value)
+//
+// Because it only depends on the seed and the public parameters of the
+// vote, any machine that has re-imported the mnemonic can reproduce the
+// exact same salt - and therefore the exact same commit hash - without
+// needing the original vault record at all.
+func (s *SeedStore) DeriveVote(passphrase, chainID, realmPath, requestID, value string) (salt, hash string, err error) {
+	entropy, err := s.load(passphrase)
+	if err != nil {
+		return "", "", err
+	}
+
+	info := []byte(chainID + realmPath + requestID + value)
+	kdf := hkdf.New(sha256.New, entropy, nil, info)
+	saltBytes := make([]byte, 32)
+	if _, err := io.ReadFull(kdf, saltBytes); err != nil {
+		return "", "", fmt.Errorf("failed to derive salt: %w", err)
+	}
+
+	salt = hex.EncodeToString(saltBytes)
+	hash = generateVoteHash(value, salt)
+	return salt, hash, nil
+}
+
+// generateVoteHash mirrors utils.GenerateVoteHash's algorithm exactly
+// (SHA256 of value+salt) without vault taking a dependency on internal/utils,
+// which every other file in this package also avoids.
+func generateVoteHash(value, salt string) string {
+	sum := sha256.Sum256([]byte(value + salt))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *SeedStore) save(entropy []byte, passphrase string) error {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate KDF salt: %w", err)
+	}
+	encKey, hmacKey := deriveKeys(passphrase, salt)
+
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	ciphertext, err := seal(encKey, nonce, entropy)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt seed: %w", err)
+	}
+	sum := computeHMAC(hmacKey, salt, nonce, ciphertext)
+
+	data, err := json.MarshalIndent(seedFile{
+		KDFSalt:    hex.EncodeToString(salt),
+		Nonce:      hex.EncodeToString(nonce),
+		Ciphertext: hex.EncodeToString(ciphertext),
+		HMAC:       hex.EncodeToString(sum),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal seed file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return fmt.Errorf("failed to create seed directory: %w", err)
+	}
+	return os.WriteFile(s.path, data, 0600)
+}
+
+func (s *SeedStore) load(passphrase string) ([]byte, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read seed file: %w", err)
+	}
+	var sf seedFile
+	if err := json.Unmarshal(data, &sf); err != nil {
+		return nil, fmt.Errorf("failed to parse seed file: %w", err)
+	}
+
+	salt, err := hex.DecodeString(sf.KDFSalt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode KDF salt: %w", err)
+	}
+	nonce, err := hex.DecodeString(sf.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode nonce: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(sf.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+	sum, err := hex.DecodeString(sf.HMAC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode HMAC: %w", err)
+	}
+
+	encKey, hmacKey := deriveKeys(passphrase, salt)
+	if !verifyHMAC(hmacKey, sum, salt, nonce, ciphertext) {
+		return nil, fmt.Errorf("wrong passphrase or corrupted seed file")
+	}
+
+	entropy, err := open(encKey, nonce, ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt seed: %w", err)
+	}
+	return entropy, nil
+}
+
+// DeterministicSalt derives requestID/value's commit salt and hash from the
+// local seed, generating a new seed on this machine's first use - in which
+// case mnemonic is the freshly generated recovery phrase the caller must
+// show the user immediately; mnemonic is empty on every later call.
+func DeterministicSalt(passphrase, chainID, realmPath, requestID, value string) (salt, hash, mnemonic string, err error) {
+	store, err := OpenSeedStore()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if !store.Exists() {
+		mnemonic, err = store.Generate(passphrase)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to generate recovery seed: %w", err)
+		}
+	}
+
+	salt, hash, err = store.DeriveVote(passphrase, chainID, realmPath, requestID, value)
+	if err != nil {
+		return "", "", "", err
+	}
+	return salt, hash, mnemonic, nil
+}
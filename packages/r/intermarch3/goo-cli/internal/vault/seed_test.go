@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func newTestSeedStore(t *testing.T) *SeedStore {
+	t.Helper()
+	return &SeedStore{path: filepath.Join(t.TempDir(), "seed")}
+}
+
+func TestDeriveVoteDeterministic(t *testing.T) {
+	store := newTestSeedStore(t)
+	if _, err := store.Generate("correct horse battery staple"); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	salt1, hash1, err := store.DeriveVote("correct horse battery staple", "dev", "gno.land/r/intermarch3/oracle", "0000001", "42")
+	if err != nil {
+		t.Fatalf("DeriveVote failed: %v", err)
+	}
+	salt2, hash2, err := store.DeriveVote("correct horse battery staple", "dev", "gno.land/r/intermarch3/oracle", "0000001", "42")
+	if err != nil {
+		t.Fatalf("DeriveVote failed: %v", err)
+	}
+
+	if salt1 != salt2 || hash1 != hash2 {
+		t.Fatalf("DeriveVote wasn't stable across calls: (%s, %s) vs (%s, %s)", salt1, hash1, salt2, hash2)
+	}
+}
+
+func TestDeriveVoteVariesByInput(t *testing.T) {
+	store := newTestSeedStore(t)
+	if _, err := store.Generate("correct horse battery staple"); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	baseSalt, baseHash, err := store.DeriveVote("correct horse battery staple", "dev", "gno.land/r/intermarch3/oracle", "0000001", "42")
+	if err != nil {
+		t.Fatalf("DeriveVote failed: %v", err)
+	}
+
+	otherRequest, _, err := store.DeriveVote("correct horse battery staple", "dev", "gno.land/r/intermarch3/oracle", "0000002", "42")
+	if err != nil {
+		t.Fatalf("DeriveVote failed: %v", err)
+	}
+	if otherRequest == baseSalt {
+		t.Fatal("DeriveVote produced the same salt for a different request ID")
+	}
+
+	otherValue, otherHash, err := store.DeriveVote("correct horse battery staple", "dev", "gno.land/r/intermarch3/oracle", "0000001", "43")
+	if err != nil {
+		t.Fatalf("DeriveVote failed: %v", err)
+	}
+	if otherValue == baseSalt || otherHash == baseHash {
+		t.Fatal("DeriveVote produced the same salt/hash for a different vote value")
+	}
+}
+
+func TestSeedGenerateImportRoundTrip(t *testing.T) {
+	original := newTestSeedStore(t)
+	mnemonic, err := original.Generate("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	salt, hash, err := original.DeriveVote("correct horse battery staple", "dev", "gno.land/r/intermarch3/oracle", "0000001", "42")
+	if err != nil {
+		t.Fatalf("DeriveVote failed: %v", err)
+	}
+
+	recovered := newTestSeedStore(t)
+	if err := recovered.ImportMnemonic(mnemonic, "a different passphrase"); err != nil {
+		t.Fatalf("ImportMnemonic failed: %v", err)
+	}
+
+	recoveredSalt, recoveredHash, err := recovered.DeriveVote("a different passphrase", "dev", "gno.land/r/intermarch3/oracle", "0000001", "42")
+	if err != nil {
+		t.Fatalf("DeriveVote on recovered seed failed: %v", err)
+	}
+
+	if recoveredSalt != salt || recoveredHash != hash {
+		t.Fatalf("recovered seed derived a different vote: (%s, %s) vs original (%s, %s)", recoveredSalt, recoveredHash, salt, hash)
+	}
+}
+
+func TestSeedLoadRejectsWrongPassphrase(t *testing.T) {
+	store := newTestSeedStore(t)
+	if _, err := store.Generate("correct horse battery staple"); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if _, _, err := store.DeriveVote("wrong passphrase", "dev", "gno.land/r/intermarch3/oracle", "0000001", "42"); err == nil {
+		t.Fatal("expected DeriveVote to fail with the wrong passphrase, got no error")
+	}
+}
@@ -0,0 +1,248 @@
+// Package vault persists the (requestID, value, salt, hash) tuple behind a
+// commit-reveal vote across separate `goo` invocations, so a user who
+// commits in one process and reveals in another doesn't forfeit their bond
+// by losing the salt. Records are namespaced under
+// ~/.goo/votes/<chainID>/<keyName> so multiple accounts and chains never
+// collide or leak each other's metadata, and are encrypted at rest with
+// ChaCha20-Poly1305 keyed off an Argon2id-stretched passphrase. Each
+// record also carries an HMAC over its clear-text scheduling metadata, so
+// a tampered reveal deadline or request ID is caught instead of silently
+// trusted.
+package vault
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// PromptPassphrase reads the vault passphrase from stdin. We reuse the
+// gnokey keyring passphrase by convention so users only remember one
+// secret, but the vault never reads the keyring itself - it just derives
+// its encryption and HMAC keys from whatever is typed here.
+func PromptPassphrase(prompt string) (string, error) {
+	fmt.Print(prompt)
+	reader := bufio.NewReader(os.Stdin)
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// Record is one commit-reveal vote tracked by the vault.
+type Record struct {
+	RequestID      string    `json:"request_id"`
+	Value          string    `json:"value"`
+	Salt           string    `json:"salt"`
+	Hash           string    `json:"hash"`
+	CommittedAt    time.Time `json:"committed_at"`
+	RevealDeadline time.Time `json:"reveal_deadline"`
+}
+
+// IsOpen reports whether now falls within the record's reveal window.
+func (r Record) IsOpen(now time.Time) bool {
+	return r.RevealDeadline.IsZero() || now.Before(r.RevealDeadline)
+}
+
+// fileRecord is the on-disk representation. Scheduling metadata stays in
+// the clear so List()/watch loops can filter by reveal window without a
+// passphrase; only the secret fields are encrypted. HMAC authenticates
+// the clear fields alongside KDFSalt/Nonce/Ciphertext, so none of them can
+// be swapped or edited without Reveal noticing.
+type fileRecord struct {
+	RequestID      string    `json:"request_id"`
+	CommittedAt    time.Time `json:"committed_at"`
+	RevealDeadline time.Time `json:"reveal_deadline"`
+	KDFSalt        string    `json:"kdf_salt"`
+	Nonce          string    `json:"nonce"`
+	Ciphertext     string    `json:"ciphertext"`
+	HMAC           string    `json:"hmac"`
+}
+
+type secretPayload struct {
+	Value string `json:"value"`
+	Salt  string `json:"salt"`
+	Hash  string `json:"hash"`
+}
+
+// Vault stores vote records under Dir, one encrypted file per request ID,
+// namespaced by chain and key. ChainID/KeyName are kept on the struct
+// purely for Export()'s bundle header; Dir is already scoped to them.
+type Vault struct {
+	Dir     string
+	ChainID string
+	KeyName string
+	backend Backend
+}
+
+// DefaultDir returns ~/.goo/votes, the root every account's vault is
+// namespaced under, creating it if necessary.
+func DefaultDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	dir := filepath.Join(homeDir, ".goo", "votes")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf("failed to create votes directory: %w", err)
+	}
+	return dir, nil
+}
+
+// Open opens the vault for chainID/keyName, creating
+// ~/.goo/votes/<chainID>/<keyName> if necessary. Namespacing by account
+// keeps one key's votes unreadable, and unlistable, from another key's
+// vault on the same machine.
+func Open(chainID, keyName string) (*Vault, error) {
+	root, err := DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(root, sanitizeComponent(chainID), sanitizeComponent(keyName))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create vault directory: %w", err)
+	}
+	return &Vault{Dir: dir, ChainID: chainID, KeyName: keyName, backend: FileBackend{}}, nil
+}
+
+// sanitizeComponent keeps chainID/keyName from escaping the votes
+// directory via path separators when used as directory names.
+func sanitizeComponent(s string) string {
+	s = strings.ReplaceAll(s, "/", "_")
+	s = strings.ReplaceAll(s, "\\", "_")
+	if s == "" {
+		return "_"
+	}
+	return s
+}
+
+func (v *Vault) path(requestID string) string {
+	return filepath.Join(v.Dir, requestID+".json.enc")
+}
+
+// frAuthenticatedBytes is the canonical byte encoding of fr's clear fields
+// (everything but HMAC itself), in the order computeHMAC/verifyHMAC cover.
+func frAuthenticatedBytes(fr fileRecord) [][]byte {
+	return [][]byte{
+		[]byte(fr.RequestID),
+		[]byte(fr.CommittedAt.UTC().Format(time.RFC3339Nano)),
+		[]byte(fr.RevealDeadline.UTC().Format(time.RFC3339Nano)),
+		[]byte(fr.KDFSalt),
+		[]byte(fr.Nonce),
+		[]byte(fr.Ciphertext),
+	}
+}
+
+// Commit encrypts and persists rec under requestID, keyed by passphrase.
+func (v *Vault) Commit(rec Record, passphrase string) error {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("failed to generate KDF salt: %w", err)
+	}
+	encKey, hmacKey := deriveKeys(passphrase, salt)
+
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	plaintext, err := json.Marshal(secretPayload{Value: rec.Value, Salt: rec.Salt, Hash: rec.Hash})
+	if err != nil {
+		return fmt.Errorf("failed to marshal vote secret: %w", err)
+	}
+	ciphertext, err := seal(encKey, nonce, plaintext)
+	if err != nil {
+		return err
+	}
+
+	fr := fileRecord{
+		RequestID:      rec.RequestID,
+		CommittedAt:    rec.CommittedAt,
+		RevealDeadline: rec.RevealDeadline,
+		KDFSalt:        hex.EncodeToString(salt),
+		Nonce:          hex.EncodeToString(nonce),
+		Ciphertext:     hex.EncodeToString(ciphertext),
+	}
+	fr.HMAC = hex.EncodeToString(computeHMAC(hmacKey, frAuthenticatedBytes(fr)...))
+
+	data, err := json.MarshalIndent(fr, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal vault record: %w", err)
+	}
+	if err := v.backend.Save(v.path(rec.RequestID), data); err != nil {
+		return fmt.Errorf("failed to write vault record: %w", err)
+	}
+
+	return v.upsertIndex(fr, filepath.Base(v.path(rec.RequestID)))
+}
+
+// Reveal loads and decrypts the record for requestID using passphrase.
+func (v *Vault) Reveal(requestID, passphrase string) (*Record, error) {
+	fr, err := v.readFileRecord(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := hex.DecodeString(fr.KDFSalt)
+	if err != nil {
+		return nil, fmt.Errorf("corrupt vault record: bad KDF salt: %w", err)
+	}
+	encKey, hmacKey := deriveKeys(passphrase, salt)
+
+	sum, err := hex.DecodeString(fr.HMAC)
+	if err != nil {
+		return nil, fmt.Errorf("corrupt vault record: bad hmac: %w", err)
+	}
+	if !verifyHMAC(hmacKey, sum, frAuthenticatedBytes(*fr)...) {
+		return nil, fmt.Errorf("vault record %s failed its tamper check (metadata was modified, or the passphrase is wrong)", requestID)
+	}
+
+	nonce, err := hex.DecodeString(fr.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("corrupt vault record: bad nonce: %w", err)
+	}
+	ciphertext, err := hex.DecodeString(fr.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("corrupt vault record: bad ciphertext: %w", err)
+	}
+
+	plaintext, err := open(encKey, nonce, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	var secret secretPayload
+	if err := json.Unmarshal(plaintext, &secret); err != nil {
+		return nil, fmt.Errorf("failed to parse decrypted vote secret: %w", err)
+	}
+
+	return &Record{
+		RequestID:      fr.RequestID,
+		Value:          secret.Value,
+		Salt:           secret.Salt,
+		Hash:           secret.Hash,
+		CommittedAt:    fr.CommittedAt,
+		RevealDeadline: fr.RevealDeadline,
+	}, nil
+}
+
+func (v *Vault) readFileRecord(requestID string) (*fileRecord, error) {
+	data, err := v.backend.Load(v.path(requestID))
+	if err != nil {
+		return nil, fmt.Errorf("no vote found for request %s in vault (did you commit from this machine?): %w", requestID, err)
+	}
+	var fr fileRecord
+	if err := json.Unmarshal(data, &fr); err != nil {
+		return nil, fmt.Errorf("failed to parse vault record: %w", err)
+	}
+	return &fr, nil
+}
@@ -0,0 +1,207 @@
+package gooclient
+
+import (
+	"fmt"
+	"time"
+
+	"goo-cli/internal/gnoabi"
+	"goo-cli/internal/gnokey"
+	"goo-cli/internal/metrics"
+	"goo-cli/internal/utils"
+	"goo-cli/internal/vault"
+	"goo-cli/pkg/types"
+)
+
+// Client is a typed, embeddable oracle client: construct one with New and
+// call its methods directly, without shelling out to gnokey yourself or
+// scanning error strings. internal/commands' cobra RunE functions, the
+// Discord bot, and tests can all share this instead of reimplementing the
+// CallFunction/QueryFunction plumbing.
+type Client struct {
+	executor *gnokey.TxExecutor
+}
+
+// New builds a Client from ctx. The key signing transactions comes from
+// ctx.Signer when set, otherwise ctx.KeyName.
+func New(ctx ClientContext) *Client {
+	keyName := ctx.KeyName
+	if ctx.Signer != nil {
+		keyName = ctx.Signer.KeyName()
+	}
+
+	return &Client{
+		executor: &gnokey.TxExecutor{
+			KeyName:   keyName,
+			RealmPath: ctx.RealmPath,
+			ChainID:   ctx.ChainID,
+			Remote:    ctx.Remote,
+			GasFee:    ctx.GasFee,
+			GasWanted: ctx.GasWanted,
+		},
+	}
+}
+
+// Verbose sets whether the underlying gnokey invocations print their full
+// command and output (see gnokey.TxExecutor.Verbose).
+func (c *Client) Verbose(v bool) {
+	c.executor.Verbose = v
+}
+
+// Request creates a new data request. rewardUgnot is sent with the
+// transaction as the requester reward.
+func (c *Client) Request(question string, yesno bool, deadline time.Time, rewardUgnot int64) error {
+	funcArgs := []string{
+		question,
+		utils.FormatBool(yesno),
+		fmt.Sprintf("%d", deadline.Unix()),
+	}
+	if err := c.executor.CallFunction("RequestData", funcArgs, fmt.Sprintf("%dugnot", rewardUgnot)); err != nil {
+		return classify(err)
+	}
+	metrics.RequestsCreatedTotal.Inc()
+	return nil
+}
+
+// Propose submits value as the proposed answer to requestID, querying and
+// sending the currently required bond. Returns the bond paid.
+func (c *Client) Propose(requestID, value string) (bondUgnot int64, err error) {
+	bond, err := c.executor.QueryInt64("GetBond")
+	if err != nil {
+		return 0, classify(err)
+	}
+
+	if err := c.executor.CallFunction("ProposeValue", []string{requestID, value}, fmt.Sprintf("%dugnot", bond)); err != nil {
+		return 0, classify(err)
+	}
+	return bond, nil
+}
+
+// Dispute challenges requestID's proposed value, querying and sending the
+// currently required bond. Returns the bond paid.
+func (c *Client) Dispute(requestID string) (bondUgnot int64, err error) {
+	bond, err := c.executor.QueryInt64("GetBond")
+	if err != nil {
+		return 0, classify(err)
+	}
+
+	if err := c.executor.CallFunction("DisputeData", []string{requestID}, fmt.Sprintf("%dugnot", bond)); err != nil {
+		return 0, classify(err)
+	}
+	metrics.DisputesCreatedTotal.Inc()
+	return bond, nil
+}
+
+// CommitVote hashes value+salt, submits the commit transaction, and
+// persists the vote in v's encrypted vault under passphrase for the later
+// RevealVote call. When salt is empty, it's deterministically derived from
+// the local recovery seed (vault.DeterministicSalt) rather than generated
+// at random, so the vault is a cache of the commit, not its only copy:
+// mnemonic is non-empty exactly once, the first time this machine ever
+// derives a salt, and must be shown to the caller.
+func (c *Client) CommitVote(v *vault.Vault, requestID, value, salt, passphrase string) (hash string, revealDeadline time.Time, mnemonic string, err error) {
+	dispute, err := c.QueryDispute(requestID)
+	if err != nil {
+		return "", time.Time{}, "", err
+	}
+
+	if salt == "" {
+		salt, hash, mnemonic, err = vault.DeterministicSalt(passphrase, c.executor.ChainID, c.executor.RealmPath, requestID, value)
+		if err != nil {
+			return "", time.Time{}, "", fmt.Errorf("failed to derive deterministic salt: %w", err)
+		}
+	} else {
+		hash = utils.GenerateVoteHash(value, salt)
+	}
+
+	if err := c.executor.CallFunction("VoteOnDispute", []string{requestID, hash}, ""); err != nil {
+		return "", time.Time{}, "", classify(err)
+	}
+
+	rec := vault.Record{
+		RequestID:      requestID,
+		Value:          value,
+		Salt:           salt,
+		Hash:           hash,
+		CommittedAt:    time.Now(),
+		RevealDeadline: dispute.RevealEndTime,
+	}
+	if err := v.Commit(rec, passphrase); err != nil {
+		return "", time.Time{}, "", fmt.Errorf("vote was committed on-chain but failed to save to vault: %w", err)
+	}
+
+	return hash, dispute.RevealEndTime, mnemonic, nil
+}
+
+// RevealVote loads requestID's vote from v and submits the reveal
+// transaction.
+func (c *Client) RevealVote(v *vault.Vault, requestID, passphrase string) error {
+	rec, err := v.Reveal(requestID, passphrase)
+	if err != nil {
+		return fmt.Errorf("failed to load vote from vault: %w", err)
+	}
+
+	if err := c.executor.CallFunction("RevealVote", []string{requestID, rec.Value, rec.Salt}, ""); err != nil {
+		return classify(err)
+	}
+	return nil
+}
+
+// QueryRequest fetches and decodes a single request by ID.
+func (c *Client) QueryRequest(id string) (*types.Request, error) {
+	result, err := c.executor.QueryFunction("GetRequest", []string{id})
+	if err != nil {
+		return nil, classify(err)
+	}
+	req, err := gnoabi.DecodeRequest(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode request data: %w", err)
+	}
+	return req, nil
+}
+
+// QueryDispute fetches and decodes a single dispute by request ID.
+func (c *Client) QueryDispute(id string) (*types.Dispute, error) {
+	result, err := c.executor.QueryFunction("GetDispute", []string{id})
+	if err != nil {
+		return nil, classify(err)
+	}
+	dispute, err := gnoabi.DecodeDispute(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode dispute data: %w", err)
+	}
+	return dispute, nil
+}
+
+// QueryRequestIDs lists request IDs in state ("Requested", "Proposed",
+// "Disputed", "Resolved"), or every request ID when state is empty.
+func (c *Client) QueryRequestIDs(state string) ([]string, error) {
+	funcName, args := "GetRequestsIds", []string{}
+	if state != "" {
+		funcName, args = "GetRequestsIdsWithState", []string{state}
+	}
+
+	result, err := c.executor.QueryFunction(funcName, args)
+	if err != nil {
+		return nil, classify(err)
+	}
+	return gnoabi.DecodeStringSlice(result)
+}
+
+// QueryBond returns the bond currently required to propose or dispute.
+func (c *Client) QueryBond() (int64, error) {
+	bond, err := c.executor.QueryInt64("GetBond")
+	if err != nil {
+		return 0, classify(err)
+	}
+	return bond, nil
+}
+
+// QueryRequesterReward returns the default reward a new request sends when
+// the requester doesn't specify one.
+func (c *Client) QueryRequesterReward() (int64, error) {
+	reward, err := c.executor.QueryInt64("GetRequesterReward")
+	if err != nil {
+		return 0, classify(err)
+	}
+	return reward, nil
+}
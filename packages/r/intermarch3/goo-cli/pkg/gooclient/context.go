@@ -0,0 +1,47 @@
+// Package gooclient is a typed Go client for the goo oracle realm. It
+// wraps the same gnokey shell-out plumbing the `goo` CLI uses, behind a
+// Client built from a ClientContext, so other front-ends (the Discord
+// bot, a web backend, tests) can drive requests/proposals/disputes/votes
+// without reimplementing CallFunction/QueryFunction or string-scanning
+// contract error messages.
+package gooclient
+
+// ClientContext holds everything a Client needs to sign transactions and
+// reach the chain: which account signs (KeyName, or Signer when set),
+// which realm/network to reach (RealmPath/ChainID/Remote), and the fee to
+// pay (GasFee/GasWanted). GoogleAPIKey is carried through for callers that
+// also do AI-assisted proposal research via internal/search_agent, which
+// this package doesn't perform itself.
+type ClientContext struct {
+	KeyName      string
+	RealmPath    string
+	ChainID      string
+	Remote       string
+	GasFee       string
+	GasWanted    int64
+	GoogleAPIKey string
+
+	// Signer optionally overrides which key signs transactions. Leave nil
+	// to sign with the local gnokey keyring entry named KeyName.
+	Signer Signer
+}
+
+// Signer abstracts how a Client authorizes a transaction. The only
+// implementation today (LocalKeySigner) shells out to the local gnokey
+// binary; it's an interface so a future web backend can swap in a remote
+// signer (e.g. a browser wallet) without changing Client's call sites.
+type Signer interface {
+	KeyName() string
+}
+
+type localKeySigner struct {
+	keyName string
+}
+
+func (s localKeySigner) KeyName() string { return s.keyName }
+
+// LocalKeySigner returns a Signer that signs with the local gnokey
+// keyring entry named keyName.
+func LocalKeySigner(keyName string) Signer {
+	return localKeySigner{keyName: keyName}
+}
@@ -0,0 +1,76 @@
+package gooclient
+
+import "strings"
+
+// ErrorCode classifies a contract/transport error into a stable category
+// callers can switch on, instead of string-matching error messages the
+// way internal/utils.ParseContractError does for CLI display.
+type ErrorCode string
+
+const (
+	ErrUnknown         ErrorCode = "unknown"
+	ErrRequestNotFound ErrorCode = "request_not_found"
+	ErrDisputeNotFound ErrorCode = "dispute_not_found"
+	ErrInvalidState    ErrorCode = "invalid_state"
+	ErrIncorrectBond   ErrorCode = "incorrect_bond"
+	ErrIncorrectReward ErrorCode = "incorrect_reward"
+	ErrWindowClosed    ErrorCode = "window_closed"
+	ErrAlreadyVoted    ErrorCode = "already_voted"
+	ErrNoVoteToken     ErrorCode = "no_vote_token"
+	ErrHashMismatch    ErrorCode = "hash_mismatch"
+	ErrUnauthorized    ErrorCode = "unauthorized"
+)
+
+// Error is a typed contract/transport error. Code lets callers branch on
+// the failure category programmatically (via errors.As); Message keeps
+// the underlying detail for logs and display.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	Cause   error
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Cause }
+
+var errorPatterns = []struct {
+	substr string
+	code   ErrorCode
+}{
+	{"Request with this ID does not exist", ErrRequestNotFound},
+	{"Dispute with this ID does not exist", ErrDisputeNotFound},
+	{"is not in 'Requested' state", ErrInvalidState},
+	{"is not in 'Proposed' state", ErrInvalidState},
+	{"Request is already resolved", ErrInvalidState},
+	{"Incorrect bond amount sent", ErrIncorrectBond},
+	{"Incorrect reward amount sent", ErrIncorrectReward},
+	{"Deadline for proposal has passed", ErrWindowClosed},
+	{"Dispute period has ended", ErrWindowClosed},
+	{"Vote period has ended", ErrWindowClosed},
+	{"Reveal period has ended", ErrWindowClosed},
+	{"Voter has already voted in this dispute", ErrAlreadyVoted},
+	{"You need at least 1 vote token to vote", ErrNoVoteToken},
+	{"Hash does not match the revealed value and salt", ErrHashMismatch},
+	{"Only the admin can", ErrUnauthorized},
+	{"Only admin can", ErrUnauthorized},
+}
+
+// classify wraps a raw gnokey/contract error in a typed *Error, matching
+// it against the same substrings internal/utils.ParseContractError
+// recognizes for CLI display, but returning a switchable Code instead of
+// a display string.
+func classify(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	msg := err.Error()
+	for _, p := range errorPatterns {
+		if strings.Contains(msg, p.substr) {
+			return &Error{Code: p.code, Message: msg, Cause: err}
+		}
+	}
+
+	return &Error{Code: ErrUnknown, Message: msg, Cause: err}
+}
@@ -31,27 +31,42 @@ func (s RequestState) String() string {
 type Request struct {
 	ID              string
 	Requester       string
+	CreatedAt       time.Time
 	AncillaryData   string
 	YesNoQuestion   bool
 	ProposedValue   int64
-	Deadline        time.Time
+	Proposer        string
+	ProposerBond    int64
+	Disputer        string
+	DisputerBond    int64
 	ResolutionTime  time.Time
+	WinningValue    int64
 	State           RequestState
-	Proposer        string
+	Deadline        time.Time
 	RequesterReward int64
 }
 
+// Vote represents a single voter's commit/reveal record in a dispute.
+type Vote struct {
+	Voter    string
+	Hash     string
+	Revealed bool
+	Value    int64
+}
+
 // Dispute represents a dispute on a request
 type Dispute struct {
-	RequestID           string
-	Disputer            string
-	DisputeInitiatedAt  time.Time
-	VoteEndTime         time.Time
-	RevealEndTime       time.Time
-	TotalVotes          int64
-	VotesFor            int64
-	VotesAgainst        int64
-	Resolved            bool
+	RequestID          string
+	Disputer           string
+	DisputeInitiatedAt time.Time
+	VoteEndTime        time.Time
+	RevealEndTime      time.Time
+	Votes              []Vote
+	TotalVotes         int64
+	VotesFor           int64
+	VotesAgainst       int64
+	WinningValue       int64
+	Resolved           bool
 }
 
 // VoteData represents a vote commitment stored locally